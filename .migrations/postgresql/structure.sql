@@ -61,4 +61,23 @@ CREATE TABLE IF NOT EXISTS example4 (
   email   text NOT NULL DEFAULT '',
   created timestamp WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
   updated timestamp WITH TIME ZONE NOT NULL DEFAULT current_timestamp
-);
\ No newline at end of file
+);
+DO $$ BEGIN
+  CREATE TYPE mood AS ENUM ('happy', 'sad', 'not_sure');
+EXCEPTION WHEN duplicate_object THEN NULL;
+END $$;
+DO $$ BEGIN
+  CREATE DOMAIN positive AS int CHECK (VALUE > 0);
+EXCEPTION WHEN duplicate_object THEN NULL;
+END $$;
+DO $$ BEGIN
+  CREATE DOMAIN score AS positive;
+EXCEPTION WHEN duplicate_object THEN NULL;
+END $$;
+
+CREATE TABLE IF NOT EXISTS example5 (
+  id uuid PRIMARY KEY,
+  mood mood NOT NULL DEFAULT 'happy',
+  previous mood,
+  rating score NOT NULL DEFAULT 1
+);
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS example1 (
+  id integer PRIMARY KEY,
+  int_field integer NOT NULL DEFAULT 1,
+  bigint_field bigint NOT NULL DEFAULT 1,
+  real_field real NOT NULL DEFAULT 1.0,
+  numeric_field numeric NOT NULL DEFAULT 1.0,
+  text_field text NOT NULL DEFAULT '',
+  varchar_field varchar(255) NOT NULL DEFAULT '',
+  blob_field blob NOT NULL DEFAULT x'',
+  bool_field boolean NOT NULL DEFAULT FALSE,
+  timestamp_field datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS example2 (
+  id integer PRIMARY KEY,
+  text_field text,
+  bool_field boolean,
+  timestamp_field datetime
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS example2;
+DROP TABLE IF EXISTS example1;
+-- +goose StatementEnd
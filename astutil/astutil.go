@@ -171,7 +171,9 @@ func VarList(specs ...ast.Spec) *ast.GenDecl {
 		rparen = token.NoPos
 	)
 
-	if len(specs) > 0 {
+	// specs without positions are printed relative to one another, the end of the last
+	// spec can't be used as it places its trailing comment after the declaration.
+	if len(specs) > 0 && specs[0].Pos().IsValid() {
 		lparen = specs[0].Pos()
 		rparen = specs[len(specs)-1].End()
 	}
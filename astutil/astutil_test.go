@@ -1,8 +1,11 @@
 package astutil_test
 
 import (
+	"bytes"
 	"go/ast"
+	"go/format"
 	"go/parser"
+	"go/token"
 
 	. "github.com/james-lawrence/genieql/astutil"
 	"github.com/james-lawrence/genieql/internal/errorsx"
@@ -18,6 +21,27 @@ var _ = Describe("Astutil", func() {
 		})
 	})
 
+	Describe("VarList", func() {
+		It("should keep the trailing comment of the last spec on its declaration", func() {
+			// the generated locals only carry the positions of their parsed types.
+			fset := token.NewFileSet()
+			spec := func(name, typ string) ast.Spec {
+				vspec := ValueSpec(MustParseExpr(fset, typ), ast.NewIdent(name))
+				vspec.Comment = &ast.CommentGroup{List: []*ast.Comment{{Text: "// " + name}}}
+				return vspec
+			}
+			body := Block(
+				DeclStmt(VarList(spec("c0", "sql.NullInt64"), spec("c1", "sql.NullBool"))),
+				Assign(ExprList(ast.NewIdent("c1")), token.ASSIGN, ExprList(ast.NewIdent("c0"))),
+			)
+
+			dst := bytes.NewBuffer(nil)
+			Expect(format.Node(dst, token.NewFileSet(), body)).To(Succeed())
+			Expect(dst.String()).To(ContainSubstring("c1 sql.NullBool  // c1\n\t)"))
+			Expect(dst.String()).To(ContainSubstring("c1 = c0\n"))
+		})
+	})
+
 	Describe("TypePattern", func() {
 		DescribeTable("build a query function based on the options",
 			func(pattern, provided []ast.Expr, result bool) {
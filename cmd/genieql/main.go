@@ -12,7 +12,10 @@ import (
 
 	"github.com/alecthomas/kingpin"
 	_ "github.com/duckdb/duckdb-go/v2"
+	_ "github.com/go-sql-driver/mysql"
 	_ "github.com/jackc/pgx/v5"
+	_ "github.com/mattn/go-sqlite3"
+	_ "modernc.org/sqlite"
 
 	"github.com/james-lawrence/genieql"
 	"github.com/james-lawrence/genieql/generators"
@@ -27,6 +30,10 @@ import (
 	_ "github.com/james-lawrence/genieql/internal/postgresql"
 	// register the duckdb dialect
 	_ "github.com/james-lawrence/genieql/internal/duckdb"
+	// register the sqlite dialect
+	_ "github.com/james-lawrence/genieql/internal/sqlite3"
+	// register the mysql dialect, requires the github.com/go-sql-driver/mysql driver.
+	_ "github.com/james-lawrence/genieql/internal/mysql"
 )
 
 func main() {
@@ -57,7 +64,12 @@ func main() {
 		BuildInfo: &bi,
 	}
 
+	sg := schema{
+		BuildInfo: &bi,
+	}
+
 	duckdb := duckdb{}
+	sqlite3 := sqlite3{}
 
 	bg := &sync.WaitGroup{}
 	defer bg.Wait()
@@ -88,7 +100,9 @@ func main() {
 	astcli.configure(app)
 	bootstrap.configure(app)
 	gg.configure(app)
+	sg.configure(app)
 	duckdb.configure(app)
+	sqlite3.configure(app)
 
 	if cmd, err := app.Parse(os.Args[1:]); err != nil {
 		fmts := "%s\n"
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+
+	"github.com/alecthomas/kingpin"
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/pressly/goose/v3"
+)
+
+type sqlite3 struct {
+	database   string
+	migrations string
+}
+
+func (t *sqlite3) configure(app *kingpin.Application) *kingpin.CmdClause {
+	cli := app.Command("sqlite3", "sqlite migrations using goose").Action(t.execute)
+	cli.Flag("database", "name of the database file to create").Default("sqlite3.db").StringVar(&t.database)
+	cli.Arg("migrations", "path to the migrations directory").Required().StringVar(&t.migrations)
+	return cli
+}
+
+func (t *sqlite3) execute(*kingpin.ParseContext) (err error) {
+	dbpath := filepath.Join(genieql.ConfigurationDirectory(), ".sqlite3", t.database)
+	if err = os.MkdirAll(filepath.Dir(dbpath), 0700); err != nil {
+		return err
+	}
+
+	// the pure go driver, the migrations run regardless of cgo.
+	db, err := sql.Open("sqlite", dbpath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	mprov, err := goose.NewProvider(goose.DialectSQLite3, db, os.DirFS(t.migrations))
+	if err != nil {
+		return errorsx.Wrap(err, "unable to build migration provider")
+	}
+
+	if _, err := mprov.Up(context.Background()); err != nil {
+		return errorsx.Wrap(err, "unable to run migrations")
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"log"
+	"path/filepath"
+
+	"github.com/alecthomas/kingpin"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/buildx"
+	"github.com/james-lawrence/genieql/compiler"
+	"github.com/james-lawrence/genieql/dialects"
+	"github.com/james-lawrence/genieql/generators"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/snapshot"
+)
+
+// schema commands for the schema snapshot, the lockfile recording the columns the generators
+// consult. once recorded genieql auto replays it instead of connecting to the database.
+// genieql schema snapshot -> records .genieql/default.config.lock from the database.
+type schema struct {
+	*genieql.BuildInfo
+	configName string
+	tags       []string
+}
+
+func (t *schema) configure(app *kingpin.Application) *kingpin.CmdClause {
+	cli := app.Command("schema", "commands for the schema snapshot generation replays instead of connecting to the database")
+
+	snap := cli.Command("snapshot", "record the tables and queries consulted by the generators of every package within the module").Action(t.snapshot)
+	snap.Flag("tags", "build tags to include").StringsVar(&t.tags)
+	snap.Flag("config", "name of the genieql configuration to use").Default(defaultConfigurationName).StringVar(&t.configName)
+
+	return cli
+}
+
+func (t *schema) snapshot(*kingpin.ParseContext) (err error) {
+	var (
+		config   genieql.Configuration
+		dialect  genieql.Dialect
+		recorder = snapshot.NewRecorder()
+		tags     = append(t.tags, genieql.BuildTagIgnore, genieql.BuildTagGenerate)
+		bctx     = buildx.Clone(t.BuildInfo.Build, buildx.Tags(tags...))
+	)
+
+	bctx.Dir = t.BuildInfo.WorkingDir
+
+	if config, err = genieql.NewConfiguration(
+		genieql.ConfigurationOptionLocation(filepath.Join(genieql.ConfigurationDirectory(), t.configName)),
+	); err != nil {
+		return err
+	}
+
+	if err = genieql.ReadConfiguration(&config); err != nil {
+		return err
+	}
+
+	// the snapshot is recorded from the database or migrations, never the previous snapshot.
+	if dialect, err = dialects.Connect(config); err != nil {
+		return errorsx.Wrap(err, "unable to lookup dialect")
+	}
+
+	pkgs, err := packages.Load(astcodec.LocatePackages(astcodec.LoadDir(t.BuildInfo.ModuleRoot)), "./...")
+	if err != nil {
+		return errorsx.Wrap(err, "unable to load packages")
+	}
+
+	if _, err = compiler.CompileGraph(
+		context.Background(),
+		t.configName,
+		bctx,
+		t.BuildInfo.Module,
+		pkgs,
+		generators.OptionVerbosity(t.Verbosity),
+		generators.OptionDialect(recorder.Dialect(dialect)),
+	); err != nil {
+		return err
+	}
+
+	if err = snapshot.Write(config.SnapshotPath(), recorder.Snapshot()); err != nil {
+		return err
+	}
+
+	log.Println("recorded schema snapshot", config.SnapshotPath())
+
+	return nil
+}
@@ -1455,7 +1455,7 @@ func Example1Insert1(ctx context.Context, q sqlx.Queryer, a Example1) Example1Sc
 		c21 pgtype.Int2        // smallint_field
 		c22 pgtype.Text        // text_field
 		c23 pgtype.Timestamptz // timestamp_field
-		c24 pgtype.UUIDArray
+		c24 pgtype.UUIDArray   // uuid_array
 	)
 	if err := c0.Set(a.BigintField); err != nil {
 		return NewExample1ScannerStaticRow(nil).Err(err)
@@ -1542,7 +1542,7 @@ func Example1Insert1(ctx context.Context, q sqlx.Queryer, a Example1) Example1Sc
 	}
 	if err := c24.Set(a.UUIDArray); err != nil {
 		return NewExample1ScannerStaticRow(nil).Err(err)
-	} // uuid_array
+	}
 	return NewExample1ScannerStaticRow(q.QueryRowContext(ctx, query, c0, c1, c2, c3, c4, c5, c6, c7, c8, c9, c10, c11, c12, c13, c14, c15, c16, c17, c18, c19, c20, c21, c22, c23, c24))
 }
 
@@ -1721,7 +1721,7 @@ func Example1Insert2(ctx context.Context, q sqlx.Queryer, a Example1) Example1Sc
 		c21 pgtype.Int2        // smallint_field
 		c22 pgtype.Text        // text_field
 		c23 pgtype.Timestamptz // timestamp_field
-		c24 pgtype.UUIDArray
+		c24 pgtype.UUIDArray   // uuid_array
 	)
 	if err := c0.Set(a.BigintField); err != nil {
 		return NewExample1ScannerStaticRow(nil).Err(err)
@@ -1808,7 +1808,7 @@ func Example1Insert2(ctx context.Context, q sqlx.Queryer, a Example1) Example1Sc
 	}
 	if err := c24.Set(a.UUIDArray); err != nil {
 		return NewExample1ScannerStaticRow(nil).Err(err)
-	} // uuid_array
+	}
 	return NewExample1ScannerStaticRow(q.QueryRowContext(ctx, query, c0, c1, c2, c3, c4, c5, c6, c7, c8, c9, c10, c11, c12, c13, c14, c15, c16, c17, c18, c19, c20, c21, c22, c23, c24))
 }
 
@@ -1988,7 +1988,7 @@ func Example1Insert3(ctx context.Context, q sqlx.Queryer, id int, a Example1) Ex
 		c22 pgtype.Int2        // smallint_field
 		c23 pgtype.Text        // text_field
 		c24 pgtype.Timestamptz // timestamp_field
-		c25 pgtype.UUIDArray
+		c25 pgtype.UUIDArray   // uuid_array
 	)
 	if err := c0.Set(id); err != nil {
 		return NewExample1ScannerStaticRow(nil).Err(err)
@@ -2078,7 +2078,7 @@ func Example1Insert3(ctx context.Context, q sqlx.Queryer, id int, a Example1) Ex
 	}
 	if err := c25.Set(a.UUIDArray); err != nil {
 		return NewExample1ScannerStaticRow(nil).Err(err)
-	} // uuid_array
+	}
 	return NewExample1ScannerStaticRow(q.QueryRowContext(ctx, query, c0, c1, c2, c3, c4, c5, c6, c7, c8, c9, c10, c11, c12, c13, c14, c15, c16, c17, c18, c19, c20, c21, c22, c23, c24, c25))
 }
 
@@ -1636,7 +1636,7 @@ func Example1Insert1(ctx context.Context, q sqlx.Queryer, a Example1) Example1Sc
 		c10 sql.NullString        // text_field
 		c11 ducktype.NullTime     // timestamp_field
 		c12 ducktype.NullUint64   // ubigint_field
-		c13 sql.NullInt64
+		c13 sql.NullInt64         // uinteger_field
 	)
 	c0.Valid = true
 	c0.Int64 = int64(a.BigintField)
@@ -1671,7 +1671,7 @@ func Example1Insert1(ctx context.Context, q sqlx.Queryer, a Example1) Example1Sc
 	c12.Valid = true
 	c12.V = a.UbigintField
 	c13.Valid = true
-	c13.Int64 = int64(a.UintegerField) // uinteger_field
+	c13.Int64 = int64(a.UintegerField)
 	return NewExample1ScannerStaticRow(q.QueryRowContext(ctx, query, c0, c1, c2, c3, c4, c5, c6, c7, c8, c9, c10, c11, c12, c13))
 }
 
@@ -1765,7 +1765,7 @@ func Example1Insert2(ctx context.Context, q sqlx.Queryer, a Example1) Example1Sc
 		c10 sql.NullString        // text_field
 		c11 ducktype.NullTime     // timestamp_field
 		c12 ducktype.NullUint64   // ubigint_field
-		c13 sql.NullInt64
+		c13 sql.NullInt64         // uinteger_field
 	)
 	c0.Valid = true
 	c0.Int64 = int64(a.BigintField)
@@ -1800,7 +1800,7 @@ func Example1Insert2(ctx context.Context, q sqlx.Queryer, a Example1) Example1Sc
 	c12.Valid = true
 	c12.V = a.UbigintField
 	c13.Valid = true
-	c13.Int64 = int64(a.UintegerField) // uinteger_field
+	c13.Int64 = int64(a.UintegerField)
 	return NewExample1ScannerStaticRow(q.QueryRowContext(ctx, query, c0, c1, c2, c3, c4, c5, c6, c7, c8, c9, c10, c11, c12, c13))
 }
 
@@ -1895,7 +1895,7 @@ func Example1Insert3(ctx context.Context, q sqlx.Queryer, id int, a Example1) Ex
 		c11 sql.NullString        // text_field
 		c12 ducktype.NullTime     // timestamp_field
 		c13 ducktype.NullUint64   // ubigint_field
-		c14 sql.NullInt64
+		c14 sql.NullInt64         // uinteger_field
 	)
 	c0.Valid = true
 	c0.Int64 = int64(id)
@@ -1932,7 +1932,7 @@ func Example1Insert3(ctx context.Context, q sqlx.Queryer, id int, a Example1) Ex
 	c13.Valid = true
 	c13.V = a.UbigintField
 	c14.Valid = true
-	c14.Int64 = int64(a.UintegerField) // uinteger_field
+	c14.Int64 = int64(a.UintegerField)
 	return NewExample1ScannerStaticRow(q.QueryRowContext(ctx, query, c0, c1, c2, c3, c4, c5, c6, c7, c8, c9, c10, c11, c12, c13, c14))
 }
 
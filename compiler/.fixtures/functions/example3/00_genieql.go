@@ -0,0 +1,76 @@
+//go:build genieql.generate
+// +build genieql.generate
+
+package example3
+
+import (
+	"context"
+
+	genieql "github.com/james-lawrence/genieql/ginterp"
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+func Example1(gql genieql.Structure) {
+	gql.From(
+		gql.Table("example1"),
+	)
+}
+
+// Example2 every column, other than the primary key, is nullable.
+func Example2(gql genieql.Structure) {
+	gql.From(
+		gql.Table("example2"),
+	)
+}
+
+func Example1Scanner(genieql.Scanner, func(i Example1)) {}
+
+func Example2Scanner(genieql.Scanner, func(i Example2)) {}
+
+// Example1Insert1 the scanner reads every column, id is defaulted rather than ignored
+// to ensure the database generated id is returned.
+func Example1Insert1(
+	gql genieql.Insert,
+	pattern func(ctx context.Context, q sqlx.Queryer, a Example1) NewExample1ScannerStaticRow,
+) {
+	gql.Into("example1").Default("id")
+}
+
+func Example2Insert1(
+	gql genieql.Insert,
+	pattern func(ctx context.Context, q sqlx.Queryer, a Example2) NewExample2ScannerStaticRow,
+) {
+	gql.Into("example2").OnConflict("id").DoUpdate()
+}
+
+func Example1InsertBatch1(
+	gql genieql.InsertBatch,
+	pattern func(ctx context.Context, q sqlx.Queryer, a Example1) NewExample1ScannerStatic,
+) {
+	gql.Into("example1").Batch(2)
+}
+
+func Example1Update1(
+	gql genieql.Update,
+	pattern func(ctx context.Context, q sqlx.Queryer, a Example1) NewExample1ScannerStaticRow,
+) {
+	gql.Table("example1").Ignore("id").Where("id")
+}
+
+func Example1Delete1(
+	gql genieql.Delete,
+	pattern func(ctx context.Context, q sqlx.Queryer, id int64) NewExample1ScannerStaticRow,
+) {
+	gql.Table("example1").Where("id")
+}
+
+// test simple function generation with field replacement
+// the placeholders are ordered by their appearance, sqlite binds $N by position.
+func Example1FindByBigintField(
+	gql genieql.Function,
+	pattern func(ctx context.Context, q sqlx.Queryer, p Example1) NewExample1ScannerStatic,
+) {
+	gql = gql.Query(
+		`SELECT ` + Example1ScannerStaticColumns + ` FROM example1 WHERE id = {p.ID} AND bigint_field = {p.BigintField}`,
+	)
+}
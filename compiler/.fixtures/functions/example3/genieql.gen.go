@@ -0,0 +1,983 @@
+//go:build !genieql.ignore
+// +build !genieql.ignore
+
+package example3
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// DO NOT EDIT: This File was auto generated by the following command:
+// genieql
+
+// Example1 generated by genieql
+type Example1 struct {
+	ID             int64
+	BigintField    int64
+	BlobField      []byte
+	BoolField      bool
+	IntField       int64
+	NumericField   float64
+	RealField      float64
+	TextField      string
+	TimestampField time.Time
+	VarcharField   string
+}
+
+// Example2 generated by genieql
+// Example2 every column, other than the primary key, is nullable.
+type Example2 struct {
+	ID             int64
+	BoolField      *bool
+	TextField      *string
+	TimestampField *time.Time
+}
+
+// Example1Scanner scanner interface.
+type Example1Scanner interface {
+	Scan(i *Example1) error
+	Next() bool
+	Close() error
+	Err() error
+}
+
+type errExample1Scanner struct {
+	e error
+}
+
+func (t errExample1Scanner) Scan(i *Example1) error {
+	return t.e
+}
+
+func (t errExample1Scanner) Next() bool {
+	return false
+}
+
+func (t errExample1Scanner) Err() error {
+	return t.e
+}
+
+func (t errExample1Scanner) Close() error {
+	return nil
+}
+
+// Example1ScannerStaticColumns generated by genieql
+const Example1ScannerStaticColumns = `"id","bigint_field","blob_field","bool_field","int_field","numeric_field","real_field","text_field","timestamp_field","varchar_field"`
+
+// NewExample1ScannerStatic creates a scanner that operates on a static
+// set of columns that are always returned in the same order.
+func NewExample1ScannerStatic(rows *sql.Rows, err error) Example1Scanner {
+	if err != nil {
+		return errExample1Scanner{e: err}
+	}
+
+	return example1ScannerStatic{
+		Rows: rows,
+	}
+}
+
+// example1ScannerStatic generated by genieql
+type example1ScannerStatic struct {
+	Rows *sql.Rows
+}
+
+// Scan generated by genieql
+func (t example1ScannerStatic) Scan(i *Example1) error {
+	var (
+		c0 sql.NullInt64
+		c1 sql.NullInt64
+		c2 []byte
+		c3 sql.NullBool
+		c4 sql.NullInt64
+		c5 sql.NullFloat64
+		c6 sql.NullFloat64
+		c7 sql.NullString
+		c8 sql.NullTime
+		c9 sql.NullString
+	)
+
+	if err := t.Rows.Scan(&c0, &c1, &c2, &c3, &c4, &c5, &c6, &c7, &c8, &c9); err != nil {
+		return err
+	}
+
+	if c0.Valid {
+		tmp := int64(c0.Int64)
+		i.ID = tmp
+	}
+
+	if c1.Valid {
+		tmp := int64(c1.Int64)
+		i.BigintField = tmp
+	}
+
+	i.BlobField = c2
+
+	if c3.Valid {
+		tmp := c3.Bool
+		i.BoolField = tmp
+	}
+
+	if c4.Valid {
+		tmp := int64(c4.Int64)
+		i.IntField = tmp
+	}
+
+	if c5.Valid {
+		tmp := float64(c5.Float64)
+		i.NumericField = tmp
+	}
+
+	if c6.Valid {
+		tmp := float64(c6.Float64)
+		i.RealField = tmp
+	}
+
+	if c7.Valid {
+		tmp := string(c7.String)
+		i.TextField = tmp
+	}
+
+	if c8.Valid {
+		tmp := c8.Time
+		i.TimestampField = tmp
+	}
+
+	if c9.Valid {
+		tmp := string(c9.String)
+		i.VarcharField = tmp
+	}
+
+	return t.Rows.Err()
+}
+
+// Err generated by genieql
+func (t example1ScannerStatic) Err() error {
+	return t.Rows.Err()
+}
+
+// Close generated by genieql
+func (t example1ScannerStatic) Close() error {
+	if t.Rows == nil {
+		return nil
+	}
+	return t.Rows.Close()
+}
+
+// Next generated by genieql
+func (t example1ScannerStatic) Next() bool {
+	return t.Rows.Next()
+}
+
+// NewExample1ScannerStaticRow creates a scanner that operates on a static
+// set of columns that are always returned in the same order, only scans a single row.
+func NewExample1ScannerStaticRow(row *sql.Row) Example1ScannerStaticRow {
+	return Example1ScannerStaticRow{
+		row: row,
+	}
+}
+
+// Example1ScannerStaticRow generated by genieql
+type Example1ScannerStaticRow struct {
+	err error
+	row *sql.Row
+}
+
+// Scan generated by genieql
+func (t Example1ScannerStaticRow) Scan(i *Example1) error {
+	var (
+		c0 sql.NullInt64
+		c1 sql.NullInt64
+		c2 []byte
+		c3 sql.NullBool
+		c4 sql.NullInt64
+		c5 sql.NullFloat64
+		c6 sql.NullFloat64
+		c7 sql.NullString
+		c8 sql.NullTime
+		c9 sql.NullString
+	)
+
+	if t.err != nil {
+		return t.err
+	}
+
+	if err := t.row.Scan(&c0, &c1, &c2, &c3, &c4, &c5, &c6, &c7, &c8, &c9); err != nil {
+		return err
+	}
+
+	if c0.Valid {
+		tmp := int64(c0.Int64)
+		i.ID = tmp
+	}
+
+	if c1.Valid {
+		tmp := int64(c1.Int64)
+		i.BigintField = tmp
+	}
+
+	i.BlobField = c2
+
+	if c3.Valid {
+		tmp := c3.Bool
+		i.BoolField = tmp
+	}
+
+	if c4.Valid {
+		tmp := int64(c4.Int64)
+		i.IntField = tmp
+	}
+
+	if c5.Valid {
+		tmp := float64(c5.Float64)
+		i.NumericField = tmp
+	}
+
+	if c6.Valid {
+		tmp := float64(c6.Float64)
+		i.RealField = tmp
+	}
+
+	if c7.Valid {
+		tmp := string(c7.String)
+		i.TextField = tmp
+	}
+
+	if c8.Valid {
+		tmp := c8.Time
+		i.TimestampField = tmp
+	}
+
+	if c9.Valid {
+		tmp := string(c9.String)
+		i.VarcharField = tmp
+	}
+
+	return nil
+}
+
+// Err set an error to return by scan
+func (t Example1ScannerStaticRow) Err(err error) Example1ScannerStaticRow {
+	t.err = err
+	return t
+}
+
+// NewExample1ScannerDynamic creates a scanner that operates on a dynamic
+// set of columns that can be returned in any subset/order.
+func NewExample1ScannerDynamic(rows *sql.Rows, err error) Example1Scanner {
+	if err != nil {
+		return errExample1Scanner{e: err}
+	}
+
+	return example1ScannerDynamic{
+		Rows: rows,
+	}
+}
+
+// example1ScannerDynamic generated by genieql
+type example1ScannerDynamic struct {
+	Rows *sql.Rows
+}
+
+// Scan generated by genieql
+func (t example1ScannerDynamic) Scan(i *Example1) error {
+	const (
+		cn0 = "id"
+		cn1 = "bigint_field"
+		cn2 = "blob_field"
+		cn3 = "bool_field"
+		cn4 = "int_field"
+		cn5 = "numeric_field"
+		cn6 = "real_field"
+		cn7 = "text_field"
+		cn8 = "timestamp_field"
+		cn9 = "varchar_field"
+	)
+	var (
+		ignored sql.RawBytes
+		err     error
+		columns []string
+		dst     []interface{}
+		c0      sql.NullInt64
+		c1      sql.NullInt64
+		c2      []byte
+		c3      sql.NullBool
+		c4      sql.NullInt64
+		c5      sql.NullFloat64
+		c6      sql.NullFloat64
+		c7      sql.NullString
+		c8      sql.NullTime
+		c9      sql.NullString
+	)
+
+	if columns, err = t.Rows.Columns(); err != nil {
+		return err
+	}
+
+	dst = make([]interface{}, 0, len(columns))
+
+	for _, column := range columns {
+		switch column {
+		case cn0:
+			dst = append(dst, &c0)
+		case cn1:
+			dst = append(dst, &c1)
+		case cn2:
+			dst = append(dst, &c2)
+		case cn3:
+			dst = append(dst, &c3)
+		case cn4:
+			dst = append(dst, &c4)
+		case cn5:
+			dst = append(dst, &c5)
+		case cn6:
+			dst = append(dst, &c6)
+		case cn7:
+			dst = append(dst, &c7)
+		case cn8:
+			dst = append(dst, &c8)
+		case cn9:
+			dst = append(dst, &c9)
+		default:
+			dst = append(dst, &ignored)
+		}
+	}
+
+	if err := t.Rows.Scan(dst...); err != nil {
+		return err
+	}
+
+	for _, column := range columns {
+		switch column {
+		case cn0:
+			if c0.Valid {
+				tmp := int64(c0.Int64)
+				i.ID = tmp
+			}
+
+		case cn1:
+			if c1.Valid {
+				tmp := int64(c1.Int64)
+				i.BigintField = tmp
+			}
+
+		case cn2:
+			i.BlobField = c2
+
+		case cn3:
+			if c3.Valid {
+				tmp := c3.Bool
+				i.BoolField = tmp
+			}
+
+		case cn4:
+			if c4.Valid {
+				tmp := int64(c4.Int64)
+				i.IntField = tmp
+			}
+
+		case cn5:
+			if c5.Valid {
+				tmp := float64(c5.Float64)
+				i.NumericField = tmp
+			}
+
+		case cn6:
+			if c6.Valid {
+				tmp := float64(c6.Float64)
+				i.RealField = tmp
+			}
+
+		case cn7:
+			if c7.Valid {
+				tmp := string(c7.String)
+				i.TextField = tmp
+			}
+
+		case cn8:
+			if c8.Valid {
+				tmp := c8.Time
+				i.TimestampField = tmp
+			}
+
+		case cn9:
+			if c9.Valid {
+				tmp := string(c9.String)
+				i.VarcharField = tmp
+			}
+
+		}
+	}
+
+	return t.Rows.Err()
+}
+
+// Err generated by genieql
+func (t example1ScannerDynamic) Err() error {
+	return t.Rows.Err()
+}
+
+// Close generated by genieql
+func (t example1ScannerDynamic) Close() error {
+	if t.Rows == nil {
+		return nil
+	}
+	return t.Rows.Close()
+}
+
+// Next generated by genieql
+func (t example1ScannerDynamic) Next() bool {
+	return t.Rows.Next()
+}
+
+// Example2Scanner scanner interface.
+type Example2Scanner interface {
+	Scan(i *Example2) error
+	Next() bool
+	Close() error
+	Err() error
+}
+
+type errExample2Scanner struct {
+	e error
+}
+
+func (t errExample2Scanner) Scan(i *Example2) error {
+	return t.e
+}
+
+func (t errExample2Scanner) Next() bool {
+	return false
+}
+
+func (t errExample2Scanner) Err() error {
+	return t.e
+}
+
+func (t errExample2Scanner) Close() error {
+	return nil
+}
+
+// Example2ScannerStaticColumns generated by genieql
+const Example2ScannerStaticColumns = `"id","bool_field","text_field","timestamp_field"`
+
+// NewExample2ScannerStatic creates a scanner that operates on a static
+// set of columns that are always returned in the same order.
+func NewExample2ScannerStatic(rows *sql.Rows, err error) Example2Scanner {
+	if err != nil {
+		return errExample2Scanner{e: err}
+	}
+
+	return example2ScannerStatic{
+		Rows: rows,
+	}
+}
+
+// example2ScannerStatic generated by genieql
+type example2ScannerStatic struct {
+	Rows *sql.Rows
+}
+
+// Scan generated by genieql
+func (t example2ScannerStatic) Scan(i *Example2) error {
+	var (
+		c0 sql.NullInt64
+		c1 sql.NullBool
+		c2 sql.NullString
+		c3 sql.NullTime
+	)
+
+	if err := t.Rows.Scan(&c0, &c1, &c2, &c3); err != nil {
+		return err
+	}
+
+	if c0.Valid {
+		tmp := int64(c0.Int64)
+		i.ID = tmp
+	}
+
+	if c1.Valid {
+		tmp := c1.Bool
+		i.BoolField = &tmp
+	}
+
+	if c2.Valid {
+		tmp := string(c2.String)
+		i.TextField = &tmp
+	}
+
+	if c3.Valid {
+		tmp := c3.Time
+		i.TimestampField = &tmp
+	}
+
+	return t.Rows.Err()
+}
+
+// Err generated by genieql
+func (t example2ScannerStatic) Err() error {
+	return t.Rows.Err()
+}
+
+// Close generated by genieql
+func (t example2ScannerStatic) Close() error {
+	if t.Rows == nil {
+		return nil
+	}
+	return t.Rows.Close()
+}
+
+// Next generated by genieql
+func (t example2ScannerStatic) Next() bool {
+	return t.Rows.Next()
+}
+
+// NewExample2ScannerStaticRow creates a scanner that operates on a static
+// set of columns that are always returned in the same order, only scans a single row.
+func NewExample2ScannerStaticRow(row *sql.Row) Example2ScannerStaticRow {
+	return Example2ScannerStaticRow{
+		row: row,
+	}
+}
+
+// Example2ScannerStaticRow generated by genieql
+type Example2ScannerStaticRow struct {
+	err error
+	row *sql.Row
+}
+
+// Scan generated by genieql
+func (t Example2ScannerStaticRow) Scan(i *Example2) error {
+	var (
+		c0 sql.NullInt64
+		c1 sql.NullBool
+		c2 sql.NullString
+		c3 sql.NullTime
+	)
+
+	if t.err != nil {
+		return t.err
+	}
+
+	if err := t.row.Scan(&c0, &c1, &c2, &c3); err != nil {
+		return err
+	}
+
+	if c0.Valid {
+		tmp := int64(c0.Int64)
+		i.ID = tmp
+	}
+
+	if c1.Valid {
+		tmp := c1.Bool
+		i.BoolField = &tmp
+	}
+
+	if c2.Valid {
+		tmp := string(c2.String)
+		i.TextField = &tmp
+	}
+
+	if c3.Valid {
+		tmp := c3.Time
+		i.TimestampField = &tmp
+	}
+
+	return nil
+}
+
+// Err set an error to return by scan
+func (t Example2ScannerStaticRow) Err(err error) Example2ScannerStaticRow {
+	t.err = err
+	return t
+}
+
+// NewExample2ScannerDynamic creates a scanner that operates on a dynamic
+// set of columns that can be returned in any subset/order.
+func NewExample2ScannerDynamic(rows *sql.Rows, err error) Example2Scanner {
+	if err != nil {
+		return errExample2Scanner{e: err}
+	}
+
+	return example2ScannerDynamic{
+		Rows: rows,
+	}
+}
+
+// example2ScannerDynamic generated by genieql
+type example2ScannerDynamic struct {
+	Rows *sql.Rows
+}
+
+// Scan generated by genieql
+func (t example2ScannerDynamic) Scan(i *Example2) error {
+	const (
+		cn0 = "id"
+		cn1 = "bool_field"
+		cn2 = "text_field"
+		cn3 = "timestamp_field"
+	)
+	var (
+		ignored sql.RawBytes
+		err     error
+		columns []string
+		dst     []interface{}
+		c0      sql.NullInt64
+		c1      sql.NullBool
+		c2      sql.NullString
+		c3      sql.NullTime
+	)
+
+	if columns, err = t.Rows.Columns(); err != nil {
+		return err
+	}
+
+	dst = make([]interface{}, 0, len(columns))
+
+	for _, column := range columns {
+		switch column {
+		case cn0:
+			dst = append(dst, &c0)
+		case cn1:
+			dst = append(dst, &c1)
+		case cn2:
+			dst = append(dst, &c2)
+		case cn3:
+			dst = append(dst, &c3)
+		default:
+			dst = append(dst, &ignored)
+		}
+	}
+
+	if err := t.Rows.Scan(dst...); err != nil {
+		return err
+	}
+
+	for _, column := range columns {
+		switch column {
+		case cn0:
+			if c0.Valid {
+				tmp := int64(c0.Int64)
+				i.ID = tmp
+			}
+
+		case cn1:
+			if c1.Valid {
+				tmp := c1.Bool
+				i.BoolField = &tmp
+			}
+
+		case cn2:
+			if c2.Valid {
+				tmp := string(c2.String)
+				i.TextField = &tmp
+			}
+
+		case cn3:
+			if c3.Valid {
+				tmp := c3.Time
+				i.TimestampField = &tmp
+			}
+
+		}
+	}
+
+	return t.Rows.Err()
+}
+
+// Err generated by genieql
+func (t example2ScannerDynamic) Err() error {
+	return t.Rows.Err()
+}
+
+// Close generated by genieql
+func (t example2ScannerDynamic) Close() error {
+	if t.Rows == nil {
+		return nil
+	}
+	return t.Rows.Close()
+}
+
+// Next generated by genieql
+func (t example2ScannerDynamic) Next() bool {
+	return t.Rows.Next()
+}
+
+// Example1Insert1StaticColumns generated by genieql
+const Example1Insert1StaticColumns = `DEFAULT,$1,$2,$3,$4,$5,$6,$7,$8,$9`
+
+// Example1Insert1Explode generated by genieql
+func Example1Insert1Explode(a *Example1) ([]interface{}, error) {
+	var (
+		c0 sql.NullInt64   // bigint_field
+		c1 []byte          // blob_field
+		c2 sql.NullBool    // bool_field
+		c3 sql.NullInt64   // int_field
+		c4 sql.NullFloat64 // numeric_field
+		c5 sql.NullFloat64 // real_field
+		c6 sql.NullString  // text_field
+		c7 sql.NullTime    // timestamp_field
+		c8 sql.NullString  // varchar_field
+	)
+
+	c0.Valid = true
+	c0.Int64 = int64(a.BigintField)
+
+	c1 = a.BlobField
+
+	c2.Valid = true
+	c2.Bool = a.BoolField
+
+	c3.Valid = true
+	c3.Int64 = int64(a.IntField)
+
+	c4.Valid = true
+	c4.Float64 = float64(a.NumericField)
+
+	c5.Valid = true
+	c5.Float64 = float64(a.RealField)
+
+	c6.Valid = true
+	c6.String = a.TextField
+
+	c7.Valid = true
+	c7.Time = a.TimestampField
+
+	c8.Valid = true
+	c8.String = a.VarcharField
+
+	return []interface{}{c0, c1, c2, c3, c4, c5, c6, c7, c8}, nil
+}
+
+// Example1Insert1 generated by genieql
+// Example1Insert1 the scanner reads every column, id is defaulted rather than ignored
+// to ensure the database generated id is returned.
+func Example1Insert1(ctx context.Context, q sqlx.Queryer, a Example1) Example1ScannerStaticRow {
+	const query = `INSERT INTO example1 (bigint_field,blob_field,bool_field,int_field,numeric_field,real_field,text_field,timestamp_field,varchar_field) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING id,bigint_field,blob_field,bool_field,int_field,numeric_field,real_field,text_field,timestamp_field,varchar_field`
+	var (
+		c0 sql.NullInt64   // bigint_field
+		c1 []byte          // blob_field
+		c2 sql.NullBool    // bool_field
+		c3 sql.NullInt64   // int_field
+		c4 sql.NullFloat64 // numeric_field
+		c5 sql.NullFloat64 // real_field
+		c6 sql.NullString  // text_field
+		c7 sql.NullTime    // timestamp_field
+		c8 sql.NullString  // varchar_field
+	)
+	c0.Valid = true
+	c0.Int64 = int64(a.BigintField)
+	c1 = a.BlobField
+	c2.Valid = true
+	c2.Bool = a.BoolField
+	c3.Valid = true
+	c3.Int64 = int64(a.IntField)
+	c4.Valid = true
+	c4.Float64 = float64(a.NumericField)
+	c5.Valid = true
+	c5.Float64 = float64(a.RealField)
+	c6.Valid = true
+	c6.String = a.TextField
+	c7.Valid = true
+	c7.Time = a.TimestampField
+	c8.Valid = true
+	c8.String = a.VarcharField
+	return NewExample1ScannerStaticRow(q.QueryRowContext(ctx, query, c0, c1, c2, c3, c4, c5, c6, c7, c8))
+}
+
+// Example2Insert1StaticColumns generated by genieql
+const Example2Insert1StaticColumns = `$1,$2,$3,$4`
+
+// Example2Insert1Explode generated by genieql
+func Example2Insert1Explode(a *Example2) ([]interface{}, error) {
+	var (
+		c0 sql.NullInt64  // id
+		c1 sql.NullBool   // bool_field
+		c2 sql.NullString // text_field
+		c3 sql.NullTime   // timestamp_field
+	)
+
+	c0.Valid = true
+	c0.Int64 = int64(a.ID)
+
+	c1.Valid = true
+	c1.Bool = *a.BoolField
+
+	c2.Valid = true
+	c2.String = *a.TextField
+
+	c3.Valid = true
+	c3.Time = *a.TimestampField
+
+	return []interface{}{c0, c1, c2, c3}, nil
+}
+
+// Example2Insert1 generated by genieql
+func Example2Insert1(ctx context.Context, q sqlx.Queryer, a Example2) Example2ScannerStaticRow {
+	const query = `INSERT INTO example2 (id,bool_field,text_field,timestamp_field) VALUES ($1,$2,$3,$4) ON CONFLICT (id) DO UPDATE SET bool_field = excluded.bool_field, text_field = excluded.text_field, timestamp_field = excluded.timestamp_field RETURNING id,bool_field,text_field,timestamp_field`
+	var (
+		c0 sql.NullInt64  // id
+		c1 sql.NullBool   // bool_field
+		c2 sql.NullString // text_field
+		c3 sql.NullTime   // timestamp_field
+	)
+	c0.Valid = true
+	c0.Int64 = int64(a.ID)
+	c1.Valid = true
+	c1.Bool = *a.BoolField
+	c2.Valid = true
+	c2.String = *a.TextField
+	c3.Valid = true
+	c3.Time = *a.TimestampField
+	return NewExample2ScannerStaticRow(q.QueryRowContext(ctx, query, c0, c1, c2, c3))
+}
+
+// Example1InsertBatch1 generated by genieql
+func NewExample1InsertBatch1(ctx context.Context, q sqlx.Queryer, a ...Example1) Example1Scanner {
+	return &example1InsertBatch1{ctx: ctx, q: q, remaining: a}
+}
+
+type example1InsertBatch1 struct {
+	ctx       context.Context
+	q         sqlx.Queryer
+	remaining []Example1
+	scanner   Example1Scanner
+}
+
+func (t *example1InsertBatch1) Scan(a *Example1) error {
+	return t.scanner.Scan(a)
+}
+
+func (t *example1InsertBatch1) Err() error {
+	if t.scanner == nil {
+		return nil
+	}
+	return t.scanner.Err()
+}
+
+func (t *example1InsertBatch1) Close() error {
+	if t.scanner == nil {
+		return nil
+	}
+	return t.scanner.Close()
+}
+
+func (t *example1InsertBatch1) Next() bool {
+	var advanced bool
+	if t.scanner != nil && t.scanner.Next() {
+		return true
+	}
+	if len(t.remaining) > 0 && t.Close() == nil {
+		t.scanner, t.remaining, advanced = t.advance(t.remaining...)
+		return advanced && t.scanner.Next()
+	}
+	return false
+}
+
+func (t *example1InsertBatch1) advance(a ...Example1) (Example1Scanner, []Example1, bool) {
+	transform := func(a Example1) (c0 sql.NullInt64, c1 sql.NullInt64, c2 []byte, c3 sql.NullBool, c4 sql.NullInt64, c5 sql.NullFloat64, c6 sql.NullFloat64, c7 sql.NullString, c8 sql.NullTime, c9 sql.NullString, err error) {
+		c0.Valid = true
+		c0.Int64 = int64(a.ID)
+		c1.Valid = true
+		c1.Int64 = int64(a.BigintField)
+		c2 = a.BlobField
+		c3.Valid = true
+		c3.Bool = a.BoolField
+		c4.Valid = true
+		c4.Int64 = int64(a.IntField)
+		c5.Valid = true
+		c5.Float64 = float64(a.NumericField)
+		c6.Valid = true
+		c6.Float64 = float64(a.RealField)
+		c7.Valid = true
+		c7.String = a.TextField
+		c8.Valid = true
+		c8.Time = a.TimestampField
+		c9.Valid = true
+		c9.String = a.VarcharField
+		return c0, c1, c2, c3, c4, c5, c6, c7, c8, c9, nil
+	}
+	if len(a) == 0 {
+		return nil, []Example1(nil), false
+	}
+	n := min(len(a), 2)
+	const queryPrefix = `INSERT INTO example1 (id,bigint_field,blob_field,bool_field,int_field,numeric_field,real_field,text_field,timestamp_field,varchar_field) VALUES `
+	const querySuffix = ` RETURNING id,bigint_field,blob_field,bool_field,int_field,numeric_field,real_field,text_field,timestamp_field,varchar_field`
+	valueTuples := [2]string{`($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)`, `($11,$12,$13,$14,$15,$16,$17,$18,$19,$20)`}
+	query := queryPrefix + strings.Join(valueTuples[:n], `,`) + querySuffix
+	args := make([]any, 0, n*10)
+	for i := range n {
+		c0, c1, c2, c3, c4, c5, c6, c7, c8, c9, err := transform(a[i])
+		if err != nil {
+			return NewExample1ScannerStatic(nil, err), []Example1(nil), false
+		}
+		args = append(args, c0, c1, c2, c3, c4, c5, c6, c7, c8, c9)
+	}
+	return NewExample1ScannerStatic(t.q.QueryContext(t.ctx, query, args...)), a[n:], true
+}
+
+// Example1Update1 generated by genieql
+func Example1Update1(ctx context.Context, q sqlx.Queryer, a Example1) Example1ScannerStaticRow {
+	const query = `UPDATE example1 SET bigint_field = $1, blob_field = $2, bool_field = $3, int_field = $4, numeric_field = $5, real_field = $6, text_field = $7, timestamp_field = $8, varchar_field = $9 WHERE id = $10 RETURNING id,bigint_field,blob_field,bool_field,int_field,numeric_field,real_field,text_field,timestamp_field,varchar_field`
+	var (
+		c0 sql.NullInt64   // bigint_field
+		c1 []byte          // blob_field
+		c2 sql.NullBool    // bool_field
+		c3 sql.NullInt64   // int_field
+		c4 sql.NullFloat64 // numeric_field
+		c5 sql.NullFloat64 // real_field
+		c6 sql.NullString  // text_field
+		c7 sql.NullTime    // timestamp_field
+		c8 sql.NullString  // varchar_field
+		c9 sql.NullInt64   // id
+	)
+	c0.Valid = true
+	c0.Int64 = int64(a.BigintField)
+	c1 = a.BlobField
+	c2.Valid = true
+	c2.Bool = a.BoolField
+	c3.Valid = true
+	c3.Int64 = int64(a.IntField)
+	c4.Valid = true
+	c4.Float64 = float64(a.NumericField)
+	c5.Valid = true
+	c5.Float64 = float64(a.RealField)
+	c6.Valid = true
+	c6.String = a.TextField
+	c7.Valid = true
+	c7.Time = a.TimestampField
+	c8.Valid = true
+	c8.String = a.VarcharField
+	c9.Valid = true
+	c9.Int64 = int64(a.ID)
+	return NewExample1ScannerStaticRow(q.QueryRowContext(ctx, query, c0, c1, c2, c3, c4, c5, c6, c7, c8, c9))
+}
+
+// Example1Delete1 generated by genieql
+func Example1Delete1(ctx context.Context, q sqlx.Queryer, id int64) Example1ScannerStaticRow {
+	const query = `DELETE FROM example1 WHERE id = $1 RETURNING id,bigint_field,blob_field,bool_field,int_field,numeric_field,real_field,text_field,timestamp_field,varchar_field`
+	var c0 sql.NullInt64
+	c0.Valid = true
+	c0.Int64 = id // id
+	return NewExample1ScannerStaticRow(q.QueryRowContext(ctx, query, c0))
+}
+
+// Example1FindByBigintField generated by genieql
+// test simple function generation with field replacement
+// the placeholders are ordered by their appearance, sqlite binds $N by position.
+func Example1FindByBigintField(ctx context.Context, q sqlx.Queryer, p Example1) Example1Scanner {
+	const query = `SELECT "id","bigint_field","blob_field","bool_field","int_field","numeric_field","real_field","text_field","timestamp_field","varchar_field" FROM example1 WHERE id = $1 AND bigint_field = $2`
+	var (
+		c0 sql.NullInt64 // id
+		c1 sql.NullInt64 // bigint_field
+	)
+	c0.Valid = true
+	c0.Int64 = int64(p.ID)
+	c1.Valid = true
+	c1.Int64 = int64(p.BigintField)
+	return NewExample1ScannerStatic(q.QueryContext(ctx, query, c0, c1))
+}
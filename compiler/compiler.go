@@ -46,6 +46,7 @@ const (
 	PriorityStructure = iota
 	PriorityScanners
 	PriorityFunctions
+	PriorityPrepared
 )
 
 // Result of a matcher
@@ -177,6 +178,8 @@ func (t Context) Compile(ctx context.Context, dst io.Writer, sources ...*ast.Fil
 		imports = astcodec.SearchImports(file, func(is *ast.ImportSpec) bool { return true })
 	}
 
+	// the scratch file is part of the package so later generators (i.e. genieql.Prepared)
+	// observe the functions generated by the current run.
 	t.CurrentPackage.GoFiles = append(t.CurrentPackage.GoFiles, filepath.Base(working.Name()))
 
 	if err = genieql.PrintPackage(printer, working, t.Context.FileSet, t.Context.CurrentPackage, t.Context.OSArgs, imports); err != nil {
@@ -372,6 +375,33 @@ func generate(ctx context.Context, cctx Context, tmpdir string, buf *bytes.Buffe
 
 		return 0
 	}).Export("genieql/astcodec.LocatePackage")
+	hostenvmb.NewFunctionBuilder().WithFunc(func(ctx context.Context, m api.Module, pathptr uint32, pathlen uint32, rlen uint32, rptr uint32) (errcode uint32) {
+		path, err := ffihost.ReadString(m.Memory(), pathptr, pathlen)
+		if err != nil {
+			log.Println("unable to read path", err)
+			return 1
+		}
+
+		// files are resolved relative to the package and must reside within the module.
+		path = filepath.Join(cctx.CurrentPackage.Dir, path)
+		if rel, err := filepath.Rel(cctx.ModuleRoot, path); err != nil || !filepath.IsLocal(rel) {
+			log.Println("unable to read file outside of the module", path)
+			return 1
+		}
+
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			log.Println("unable to read file", err)
+			return 1
+		}
+
+		if err = ffihost.WriteBytes(m.Memory(), 2*bytesx.MiB, rptr, rlen, raw); err != nil {
+			log.Println(errorsx.Wrap(err, "unable to write file contents"))
+			return 1
+		}
+
+		return 0
+	}).Export("genieql/ginterp.ReadFile")
 	hostenvmb.NewFunctionBuilder().WithFunc(func(ctx context.Context, m api.Module, sptr uint32, slen uint32, rlen uint32, rptr uint32) (errcode uint32) {
 		s, err := ffihost.ReadString(m.Memory(), sptr, slen)
 		if err != nil {
@@ -390,6 +420,38 @@ func generate(ctx context.Context, cctx Context, tmpdir string, buf *bytes.Buffe
 
 		return 0
 	}).Export("genieql/dialect.QuotedString")
+	hostenvmb.NewFunctionBuilder().WithFunc(func(ctx context.Context, m api.Module, sptr uint32, slen uint32, rlen uint32, rptr uint32) (errcode uint32) {
+		s, err := ffihost.ReadString(m.Memory(), sptr, slen)
+		if err != nil {
+			return 1
+		}
+
+		qs := cctx.Dialect.Membership(s)
+
+		if !m.Memory().WriteUint32Le(rlen, uint32(len(qs))) {
+			return 1
+		}
+
+		if !m.Memory().WriteString(rptr, qs) {
+			return 1
+		}
+
+		return 0
+	}).Export("genieql/dialect.Membership")
+	hostenvmb.NewFunctionBuilder().WithFunc(func(ctx context.Context, m api.Module, rptr uint32) (errcode uint32) {
+		if !m.Memory().WriteUint32Le(rptr, uint32(cctx.Dialect.MaxParameters())) {
+			return 1
+		}
+
+		return 0
+	}).Export("genieql/dialect.MaxParameters")
+	hostenvmb.NewFunctionBuilder().WithFunc(func(ctx context.Context, m api.Module, rptr uint32) (errcode uint32) {
+		if err := ffihost.WriteBool(m.Memory(), rptr, cctx.Dialect.Returning()); err != nil {
+			return 1
+		}
+
+		return 0
+	}).Export("genieql/dialect.Returning")
 	hostenvmb.NewFunctionBuilder().WithFunc(func(
 		ctx context.Context,
 		m api.Module,
@@ -412,6 +474,51 @@ func generate(ctx context.Context, cctx Context, tmpdir string, buf *bytes.Buffe
 
 		return 0
 	}).Export("genieql/dialect.ColumnInformationForQuery")
+	hostenvmb.NewFunctionBuilder().WithFunc(func(
+		ctx context.Context,
+		m api.Module,
+		qptr uint32, qlen uint32, rlen uint32, rptr uint32) (errcode uint32) {
+		s, err := ffihost.ReadString(m.Memory(), qptr, qlen)
+		if err != nil {
+			return 1
+		}
+
+		pinfo, err := cctx.Dialect.ParameterInformationForQuery(cctx.Driver, s)
+		if err != nil {
+			log.Println(err)
+			return 1
+		}
+
+		if err = ffihost.WriteJSON(m.Memory(), 2*bytesx.MiB, rptr, rlen, pinfo); err != nil {
+			log.Println(errorsx.Wrap(err, "unable to write parameter information"))
+			return 1
+		}
+
+		return 0
+	}).Export("genieql/dialect.ParameterInformationForQuery")
+	hostenvmb.NewFunctionBuilder().WithFunc(func(
+		ctx context.Context,
+		m api.Module,
+		qptr uint32, qlen uint32, rlen uint32, rptr uint32) (errcode uint32) {
+		s, err := ffihost.ReadString(m.Memory(), qptr, qlen)
+		if err != nil {
+			return 1
+		}
+
+		var invalid []byte
+		if cause := cctx.Dialect.Validate(s); cause != nil {
+			// truncate the message to the buffer of the guest.
+			invalid = []byte(cause.Error())
+			invalid = invalid[:min(len(invalid), 64*bytesx.KiB)]
+		}
+
+		if err = ffihost.WriteBytes(m.Memory(), 64*bytesx.KiB, rptr, rlen, invalid); err != nil {
+			log.Println(errorsx.Wrap(err, "unable to write validation result"))
+			return 1
+		}
+
+		return 0
+	}).Export("genieql/dialect.Validate")
 	hostenvmb.NewFunctionBuilder().WithFunc(func(
 		ctx context.Context,
 		m api.Module,
@@ -575,6 +682,107 @@ func generate(ctx context.Context, cctx Context, tmpdir string, buf *bytes.Buffe
 
 		return 0
 	}).Export("genieql/dialect.Update")
+	hostenvmb.NewFunctionBuilder().WithFunc(func(
+		ctx context.Context,
+		m api.Module,
+		n int64,
+		tableptr uint32, tablelen uint32,
+		columnsptr uint32, columnslen uint32, columnssize uint32,
+		typesptr uint32, typeslen uint32, typessize uint32,
+		predicatesptr uint32, predicateslen uint32, predicatessize uint32,
+		returningptr uint32, returninglen uint32, returningsize uint32,
+		rlen uint32,
+		rptr uint32,
+	) (errcode uint32) {
+		table, err := ffihost.ReadString(m.Memory(), tableptr, tablelen)
+		if err != nil {
+			log.Println("unable to read table", err)
+			return 1
+		}
+
+		columns, err := ffihost.ReadStringArray(m.Memory(), columnsptr, columnslen, columnssize)
+		if err != nil {
+			log.Println("unable to read columns", err)
+			return 1
+		}
+
+		types, err := ffihost.ReadStringArray(m.Memory(), typesptr, typeslen, typessize)
+		if err != nil {
+			log.Println("unable to read types", err)
+			return 1
+		}
+
+		predicates, err := ffihost.ReadStringArray(m.Memory(), predicatesptr, predicateslen, predicatessize)
+		if err != nil {
+			log.Println("unable to read predicates", err)
+			return 1
+		}
+
+		returns, err := ffihost.ReadStringArray(m.Memory(), returningptr, returninglen, returningsize)
+		if err != nil {
+			log.Println("unable to read returns", err)
+			return 1
+		}
+
+		qs := cctx.Dialect.UpdateBatch(int(n), table, columns, types, predicates, returns)
+
+		if !m.Memory().WriteUint32Le(rlen, uint32(len(qs))) {
+			return 1
+		}
+
+		if !m.Memory().WriteString(rptr, qs) {
+			return 1
+		}
+
+		return 0
+	}).Export("genieql/dialect.UpdateBatch")
+	hostenvmb.NewFunctionBuilder().WithFunc(func(
+		ctx context.Context,
+		m api.Module,
+		n int64,
+		tableptr uint32, tablelen uint32,
+		columnsptr uint32, columnslen uint32, columnssize uint32,
+		typesptr uint32, typeslen uint32, typessize uint32,
+		returningptr uint32, returninglen uint32, returningsize uint32,
+		rlen uint32,
+		rptr uint32,
+	) (errcode uint32) {
+		table, err := ffihost.ReadString(m.Memory(), tableptr, tablelen)
+		if err != nil {
+			log.Println("unable to read table", err)
+			return 1
+		}
+
+		columns, err := ffihost.ReadStringArray(m.Memory(), columnsptr, columnslen, columnssize)
+		if err != nil {
+			log.Println("unable to read columns", err)
+			return 1
+		}
+
+		types, err := ffihost.ReadStringArray(m.Memory(), typesptr, typeslen, typessize)
+		if err != nil {
+			log.Println("unable to read types", err)
+			return 1
+		}
+
+		returns, err := ffihost.ReadStringArray(m.Memory(), returningptr, returninglen, returningsize)
+		if err != nil {
+			log.Println("unable to read returns", err)
+			return 1
+		}
+
+		qs := cctx.Dialect.DeleteBatch(int(n), table, columns, types, returns)
+
+		if !m.Memory().WriteUint32Le(rlen, uint32(len(qs))) {
+			return 1
+		}
+
+		if !m.Memory().WriteString(rptr, qs) {
+			return 1
+		}
+
+		return 0
+	}).Export("genieql/dialect.DeleteBatch")
 	hostenvmb.NewFunctionBuilder().WithFunc(func(
 		ctx context.Context,
 		m api.Module,
@@ -614,6 +822,78 @@ func generate(ctx context.Context, cctx Context, tmpdir string, buf *bytes.Buffe
 
 		return 0
 	}).Export("genieql/dialect.Delete")
+	hostenvmb.NewFunctionBuilder().WithFunc(func(
+		ctx context.Context,
+		m api.Module,
+		targetptr uint32, targetlen uint32, targetsize uint32,
+		updatesptr uint32, updateslen uint32, updatessize uint32,
+		rlen uint32,
+		rptr uint32,
+	) (errcode uint32) {
+		target, err := ffihost.ReadStringArray(m.Memory(), targetptr, targetlen, targetsize)
+		if err != nil {
+			log.Println("unable to read target", err)
+			return 1
+		}
+
+		updates, err := ffihost.ReadStringArray(m.Memory(), updatesptr, updateslen, updatessize)
+		if err != nil {
+			log.Println("unable to read updates", err)
+			return 1
+		}
+
+		qs := cctx.Dialect.Conflict(target, updates)
+
+		if !m.Memory().WriteUint32Le(rlen, uint32(len(qs))) {
+			return 1
+		}
+
+		if !m.Memory().WriteString(rptr, qs) {
+			return 1
+		}
+
+		return 0
+	}).Export("genieql/dialect.Conflict")
+	hostenvmb.NewFunctionBuilder().WithFunc(func(
+		ctx context.Context,
+		m api.Module,
+		tableptr uint32, tablelen uint32,
+		columnsptr uint32, columnslen uint32, columnssize uint32,
+		keysptr uint32, keyslen uint32, keyssize uint32,
+		after uint32,
+		rlen uint32,
+		rptr uint32,
+	) (errcode uint32) {
+		table, err := ffihost.ReadString(m.Memory(), tableptr, tablelen)
+		if err != nil {
+			log.Println("unable to read table", err)
+			return 1
+		}
+
+		columns, err := ffihost.ReadStringArray(m.Memory(), columnsptr, columnslen, columnssize)
+		if err != nil {
+			log.Println("unable to read columns", err)
+			return 1
+		}
+
+		keys, err := ffihost.ReadStringArray(m.Memory(), keysptr, keyslen, keyssize)
+		if err != nil {
+			log.Println("unable to read keys", err)
+			return 1
+		}
+
+		qs := cctx.Dialect.Paginate(table, columns, keys, after != 0)
+
+		if !m.Memory().WriteUint32Le(rlen, uint32(len(qs))) {
+			return 1
+		}
+
+		if !m.Memory().WriteString(rptr, qs) {
+			return 1
+		}
+
+		return 0
+	}).Export("genieql/dialect.Paginate")
 	if menv, err := hostenvmb.Instantiate(ctx); err != nil {
 		return errorsx.Wrap(err, "failed to instantiate module")
 	} else {
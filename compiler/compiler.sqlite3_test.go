@@ -0,0 +1,68 @@
+package compiler_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"go/build"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/buildx"
+	"github.com/james-lawrence/genieql/compiler"
+	"github.com/james-lawrence/genieql/generators"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	_ "github.com/james-lawrence/genieql/internal/sqlite3"
+	"github.com/james-lawrence/genieql/internal/sqlxtest"
+	"github.com/james-lawrence/genieql/internal/testx"
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/database"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLite(t *testing.T) {
+	sqlitetest := func(ctx context.Context, t *testing.T, dir string, resultpath string) {
+		var (
+			err error
+			buf = bytes.NewBuffer(nil)
+		)
+
+		bctx := buildx.Clone(
+			build.Default,
+			buildx.Tags(genieql.BuildTagIgnore, genieql.BuildTagGenerate),
+		)
+
+		pkg, err := bctx.ImportDir(errorsx.Must(filepath.Abs(dir)), build.IgnoreVendor)
+		require.NoError(t, err)
+
+		pkg.ImportPath = "github.com/james-lawrence/genieql/compiler/.fixtures/functions/example3"
+		gctx, err := generators.NewContext(
+			bctx,
+			"sqlite3.test.config",
+			pkg,
+			generators.OptionOSArgs(),
+			// generators.OptionDebug,
+		)
+		require.NoError(t, err)
+
+		gctx.Dialect.(interface{ SQLDB(func(*sql.DB)) }).SQLDB(func(db *sql.DB) {
+			store := errorsx.Must(database.NewStore(database.DialectSQLite3, goose.DefaultTablename))
+			require.NoError(t, sqlxtest.Migrate(ctx, db, os.DirFS("../.migrations/sqlite3"), goose.WithStore(store)))
+		})
+
+		require.NoError(t, compiler.Autocompile(ctx, gctx, buf))
+		formatted, err := astcodec.Format(buf.String())
+		require.NoError(t, err)
+
+		expected := testx.ReadString(resultpath)
+		errorsx.MaybePanic(os.WriteFile(resultpath, []byte(formatted), 0600))
+		require.EqualValues(t, expected, formatted)
+	}
+
+	t.Run("example 3", func(t *testing.T) {
+		sqlitetest(t.Context(), t, "./.fixtures/functions/example3", ".fixtures/functions/example3/genieql.gen.go")
+	})
+}
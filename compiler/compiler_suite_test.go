@@ -7,8 +7,10 @@ import (
 
 	_ "github.com/duckdb/duckdb-go/v2"
 	"github.com/james-lawrence/genieql/internal/testx"
+	_ "github.com/mattn/go-sqlite3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	_ "modernc.org/sqlite"
 )
 
 func TestCompiler(t *testing.T) {
@@ -195,6 +195,35 @@ func (t *dependencygraph) compilepackage(ctx context.Context, node *packagenode)
 }
 
 func AutoCompileGraph(ctx context.Context, configname string, bctx build.Context, module string, output string, pkgs []*packages.Package, opts ...generators.Option) (map[string]error, error) {
+	emit := func(node *packagenode) (err error) {
+		var (
+			outpath = filepath.Join(node.Pkg.Dir, output)
+			outfile *os.File
+		)
+
+		if outfile, err = os.Create(outpath); err != nil {
+			return errorsx.Wrapf(err, "failed to create output file for %s", node.Pkg.ImportPath)
+		}
+		defer outfile.Close()
+
+		if err = genieql.NewCopyGenerator(node.Output).Generate(outfile); err != nil {
+			return errorsx.Wrapf(err, "failed to write output for %s", node.Pkg.ImportPath)
+		}
+
+		log.Printf("  wrote output for %s", node.Pkg.ImportPath)
+		return nil
+	}
+
+	return compilegraph(ctx, configname, bctx, module, pkgs, emit, opts...)
+}
+
+// CompileGraph compiles the packages like AutoCompileGraph discarding the generated code.
+// i.e.) recording the columns the generators consult.
+func CompileGraph(ctx context.Context, configname string, bctx build.Context, module string, pkgs []*packages.Package, opts ...generators.Option) (map[string]error, error) {
+	return compilegraph(ctx, configname, bctx, module, pkgs, func(*packagenode) error { return nil }, opts...)
+}
+
+func compilegraph(ctx context.Context, configname string, bctx build.Context, module string, pkgs []*packages.Package, emit func(*packagenode) error, opts ...generators.Option) (map[string]error, error) {
 	var err error
 	graph := newdependencygraph(bctx, configname, module, opts)
 
@@ -218,25 +247,6 @@ func AutoCompileGraph(ctx context.Context, configname string, bctx build.Context
 		log.Printf("  level %d: %v", i, pkgs)
 	}
 
-	emit := func(node *packagenode) error {
-		var (
-			outpath = filepath.Join(node.Pkg.Dir, output)
-			outfile *os.File
-		)
-
-		if outfile, err = os.Create(outpath); err != nil {
-			return errorsx.Wrapf(err, "failed to create output file for %s", node.Pkg.ImportPath)
-		}
-		defer outfile.Close()
-
-		if err = genieql.NewCopyGenerator(node.Output).Generate(outfile); err != nil {
-			return errorsx.Wrapf(err, "failed to write output for %s", node.Pkg.ImportPath)
-		}
-
-		log.Printf("  wrote output for %s", node.Pkg.ImportPath)
-		return nil
-	}
-
 	results := make(map[string]error)
 	for i, level := range levels {
 		log.Printf("compiling level %d (%d packages)", i, len(level))
@@ -0,0 +1,37 @@
+package compiler
+
+import (
+	"go/ast"
+	"log"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+)
+
+// Enum matcher - identifies enum generators.
+func Enum(cctx Context, src *ast.File, pos *ast.FuncDecl) (r Result, err error) {
+	var (
+		enumPattern = astutil.TypePattern(astutil.Expr("genieql.Enum"))
+	)
+
+	if !enumPattern(astutil.MapFieldsToTypeExpr(pos.Type.Params.List...)...) {
+		return r, ErrNoMatch
+	}
+
+	src = normalizeFnDecl(src)
+
+	log.Printf("genieql.Enum identified %s\n", nodeInfo(cctx, pos))
+
+	uid := errorsx.Must(uuid.NewV4()).String()
+	content := genmain(cctx.Name, cctx.CurrentPackage, pos.Name.String(), "ginterp", "EnumFromFile")
+	fndecls := astcodec.SearchFileDecls(normalizeFnDecl(src), astcodec.FindFunctions, astcodec.FilterFunctionsByName("main"))
+
+	return Result{
+		Bid:      uid,
+		Ident:    pos.Name.Name,
+		Mod:      modgenfn(genmod(cctx, pos, content, fndecls, src.Imports...)),
+		Priority: PriorityStructure,
+	}, nil
+}
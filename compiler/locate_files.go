@@ -69,11 +69,19 @@ func Autocompile(ctx context.Context, cctx generators.Context, dst io.Writer) (e
 	c := New(
 		cctx,
 		Structure,
+		Enum,
 		Scanner,
 		Function,
 		Inserts,
 		BatchInserts,
+		BatchUpdates,
+		BatchDeletes,
+		Update,
+		Delete,
+		Paginate,
+		Copy,
 		QueryAutogen,
+		Prepared,
 	)
 
 	buf := bytes.NewBuffer(nil)
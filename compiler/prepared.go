@@ -0,0 +1,42 @@
+package compiler
+
+import (
+	"go/ast"
+	"log"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+)
+
+// Prepared matcher - identifies prepared statement registry generators.
+// the registry is generated after every function so it can include them.
+func Prepared(cctx Context, src *ast.File, pos *ast.FuncDecl) (r Result, err error) {
+	var (
+		pattern = astutil.TypePattern(astutil.Expr("genieql.Prepared"))
+	)
+
+	if len(pos.Type.Params.List) != 1 {
+		cctx.Debugln("no match requires a single parameter", nodeInfo(cctx, pos))
+		return r, ErrNoMatch
+	}
+
+	if !pattern(astutil.MapFieldsToTypeExpr(pos.Type.Params.List[:1]...)...) {
+		cctx.Traceln("no match pattern", nodeInfo(cctx, pos))
+		return r, ErrNoMatch
+	}
+
+	log.Printf("genieql.Prepared identified %s\n", nodeInfo(cctx, pos))
+
+	uid := errorsx.Must(uuid.NewV4()).String()
+	content := genmain(cctx.Name, cctx.CurrentPackage, pos.Name.String(), "ginterp", "PreparedFromFile")
+	fndecls := astcodec.SearchFileDecls(normalizeFnDecl(src), astcodec.FindFunctions, astcodec.FilterFunctionsByName("main"))
+
+	return Result{
+		Bid:      uid,
+		Ident:    pos.Name.Name,
+		Mod:      modgenfn(genmod(cctx, pos, content, fndecls, src.Imports...)),
+		Priority: PriorityPrepared,
+	}, nil
+}
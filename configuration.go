@@ -32,6 +32,24 @@ type Configuration struct {
 	Username      string
 	Password      string
 	MemoryLimit   uint32
+	Validate      bool   `yaml:",omitempty"` // prepare the generated queries against the database.
+	Migrations    string `yaml:",omitempty"` // directory of DDL migrations describing the tables, generation no longer requires a database.
+}
+
+// MigrationsDirectory the directory of the migrations, relative paths are resolved against
+// the directory containing the configuration directory. i.e.) the module root.
+func (t Configuration) MigrationsDirectory() string {
+	if t.Migrations == "" || filepath.IsAbs(t.Migrations) {
+		return t.Migrations
+	}
+
+	return filepath.Join(filepath.Dir(t.Location), t.Migrations)
+}
+
+// SnapshotPath the lockfile recording the columns consulted during generation, when present
+// generation replays it instead of connecting to the database. i.e.) .genieql/default.config.lock
+func (t Configuration) SnapshotPath() string {
+	return filepath.Join(t.Location, t.Name+".lock")
 }
 
 // ReadMap the column -> struct mapping from disk cache.
@@ -192,6 +210,23 @@ func ConfigurationOptionMemory(l uint) ConfigurationOption {
 	}
 }
 
+// ConfigurationOptionValidate prepare the generated queries against the database during generation.
+func ConfigurationOptionValidate(b bool) ConfigurationOption {
+	return func(c *Configuration) error {
+		c.Validate = b
+		return nil
+	}
+}
+
+// ConfigurationOptionMigrations describe the tables using the DDL migrations of the directory
+// instead of connecting to the database.
+func ConfigurationOptionMigrations(dir string) ConfigurationOption {
+	return func(c *Configuration) error {
+		c.Migrations = dir
+		return nil
+	}
+}
+
 // ConfigurationOptionDatabase specify the database connection information.
 func ConfigurationOptionDatabase(uri *url.URL) ConfigurationOption {
 	return func(c *Configuration) (err error) {
@@ -217,6 +252,8 @@ func ConfigurationOptionDatabase(uri *url.URL) ConfigurationOption {
 		switch uri.Scheme {
 		case "duckdb":
 			database = filepath.Join(ConfigurationDirectory(), ".duckdb", uri.Path)
+		case "sqlite3", "sqlite":
+			database = filepath.Join(ConfigurationDirectory(), ".sqlite3", uri.Path)
 		default:
 			database = strings.Trim(uri.Path, "/")
 		}
@@ -228,6 +265,13 @@ func ConfigurationOptionDatabase(uri *url.URL) ConfigurationOption {
 		c.Database = database
 		c.Username = uri.User.Username()
 		c.Password, _ = uri.User.Password()
+
+		// the sqlite scheme selects the pure go driver, builds without cgo still target sqlite.
+		if uri.Scheme == "sqlite" {
+			c.Dialect = "sqlite3"
+			c.Driver = "modernc.org/sqlite"
+		}
+
 		return nil
 	}
 }
@@ -10,9 +10,42 @@ type Dialect interface {
 	Select(table string, columns, predicates []string) string
 	Update(table string, columns, predicates, returning []string) string
 	Delete(table string, columns, predicates []string) string
+	// UpdateBatch generates a query updating many rows in a single statement. the rows of values
+	// are joined against the table by the predicate columns, the remaining columns are updated.
+	// types are the database types of the columns. dialects that bind arrays bind one array
+	// per column and ignore n, otherwise the values are bound as n tuples of placeholders.
+	UpdateBatch(n int, table string, columns, types, predicates, returning []string) string
+	// DeleteBatch generates a query deleting many rows in a single statement. the rows are
+	// matched by the values of the columns, see UpdateBatch.
+	DeleteBatch(n int, table string, columns, types, returning []string) string
+	// Conflict generates the clause for resolving insert conflicts on the target columns.
+	// when no update columns are provided the conflicting rows are left untouched.
+	Conflict(target, updates []string) string
+	// Paginate generates a keyset pagination query ordered by the key columns.
+	// when after is true the rows are restricted to those following the key values.
+	// the key values are followed by the limit as the parameters of the query.
+	Paginate(table string, columns, keys []string, after bool) string
+	// Membership generates the clause matching a value against the array bound to the placeholder.
+	// returns an empty string when the dialect is unable to bind arrays, the values are then
+	// expanded into a list of placeholders at runtime.
+	Membership(placeholder string) string
+	// MaxParameters the maximum number of parameters a single statement is able to bind.
+	// zero when the dialect has no limit.
+	MaxParameters() int
+	// Returning whether queries are able to return the rows they modify. when false generated
+	// inserts execute the query and select the inserted row by its primary key, using the id
+	// generated by the database for a defaulted key column.
+	Returning() bool
 	ColumnValueTransformer() ColumnTransformer
 	ColumnNameTransformer(opts ...transform.Transformer) ColumnTransformer
 	ColumnInformationForTable(d Driver, table string) ([]ColumnInfo, error)
 	ColumnInformationForQuery(d Driver, query string) ([]ColumnInfo, error)
+	// ParameterInformationForQuery the types the database expects for the placeholders of the
+	// query in placeholder order. the definition is left empty when the database is unable
+	// to determine the type, dialects without typed parameters return no information.
+	ParameterInformationForQuery(d Driver, query string) ([]ColumnInfo, error)
+	// Validate prepares the query against the database within a transaction that is
+	// rolled back, returning the error reported by the database for invalid queries.
+	Validate(query string) error
 	QuotedString(s string) string
 }
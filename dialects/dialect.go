@@ -2,6 +2,7 @@ package dialects
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/james-lawrence/genieql"
@@ -72,6 +73,13 @@ type DialectFactory interface {
 	Connect(genieql.Configuration) (genieql.Dialect, error)
 }
 
+// OfflineFactory implemented by dialect factories able to describe the tables of the database
+// from its DDL migrations without a connection. see genieql.Configuration.Migrations.
+// without migrations the dialect describes no tables.
+type OfflineFactory interface {
+	Offline(genieql.Configuration) (genieql.Dialect, error)
+}
+
 type dialectRegistry map[string]DialectFactory
 
 func (t dialectRegistry) RegisterDialect(dialect string, imp DialectFactory) error {
@@ -96,10 +104,16 @@ func (t dialectRegistry) LookupDialect(dialect string) (DialectFactory, error) {
 type Test struct {
 	Quote             string
 	CValueTransformer genieql.ColumnTransformer
+	Arrays            bool // whether the dialect binds arrays.
+	Parameters        int  // maximum number of parameters, zero for no limit.
+	LastInsertID      bool // whether inserts are unable to return rows, see genieql.Dialect.Returning.
 	QueryInsert       string
 	QuerySelect       string
 	QueryUpdate       string
 	QueryDelete       string
+	Validation        func(query string) error // validates queries, nil accepts every query.
+	// types of the placeholders of queries, nil when the parameters are untyped.
+	ParameterTypes func(d genieql.Driver, query string) ([]genieql.ColumnInfo, error)
 }
 
 func (t Test) Insert(n int, offset int, table, conflict string, columns, projection, defaults []string) string {
@@ -131,15 +145,181 @@ func (t Test) Insert(n int, offset int, table, conflict string, columns, project
 }
 
 func (t Test) Select(table string, columns, predicates []string) string {
-	return stringsx.DefaultIfBlank(t.QuerySelect, "SELECT QUERY")
+	var (
+		selectTmpl = stringsx.DefaultIfBlank(t.QuerySelect, "SELECT QUERY")
+	)
+
+	clauses, _ := assignments(1, predicates...)
+
+	replacements := strings.NewReplacer(
+		":gql.select.tablename:", table,
+		":gql.select.columns:", strings.Join(columns, ","),
+		":gql.select.predicates:", strings.Join(clauses, " AND "),
+	)
+
+	return replacements.Replace(selectTmpl)
 }
 
 func (t Test) Update(table string, columns, predicates, returning []string) string {
-	return stringsx.DefaultIfBlank(t.QueryUpdate, "UPDATE QUERY")
+	var (
+		updateTmpl = stringsx.DefaultIfBlank(t.QueryUpdate, "UPDATE QUERY")
+	)
+
+	updates, offset := assignments(1, columns...)
+	clauses, _ := assignments(offset, predicates...)
+
+	replacements := strings.NewReplacer(
+		":gql.update.tablename:", table,
+		":gql.update.columns:", strings.Join(updates, ", "),
+		":gql.update.predicates:", strings.Join(clauses, " AND "),
+		":gql.update.returning:", strings.Join(returning, ","),
+	)
+
+	return replacements.Replace(updateTmpl)
 }
 
 func (t Test) Delete(table string, columns, predicates []string) string {
-	return stringsx.DefaultIfBlank(t.QueryDelete, "DELETE QUERY")
+	var (
+		deleteTmpl = stringsx.DefaultIfBlank(t.QueryDelete, "DELETE QUERY")
+	)
+
+	clauses, _ := assignments(1, predicates...)
+
+	replacements := strings.NewReplacer(
+		":gql.delete.tablename:", table,
+		":gql.delete.predicates:", strings.Join(clauses, " AND "),
+		":gql.delete.returning:", strings.Join(columns, ","),
+	)
+
+	return replacements.Replace(deleteTmpl)
+}
+
+func (t Test) UpdateBatch(n int, table string, columns, types, predicates, returning []string) string {
+	var (
+		updates []string
+		clauses []string
+	)
+
+	for _, c := range columns {
+		if !slices.Contains(predicates, c) {
+			updates = append(updates, fmt.Sprintf("%s = v.%s", c, c))
+		}
+	}
+
+	for _, c := range predicates {
+		clauses = append(clauses, fmt.Sprintf("t.%s = v.%s", c, c))
+	}
+
+	return strings.TrimSpace(fmt.Sprintf(
+		"%s UPDATE %s AS t SET %s FROM %s WHERE %s RETURNING %s",
+		t.batchValues(n, columns, types),
+		table,
+		strings.Join(updates, ", "),
+		t.batchSource(columns, types),
+		strings.Join(clauses, " AND "),
+		strings.Join(qualified(returning...), ","),
+	))
+}
+
+func (t Test) DeleteBatch(n int, table string, columns, types, returning []string) string {
+	clauses := make([]string, 0, len(columns))
+	for _, c := range columns {
+		clauses = append(clauses, fmt.Sprintf("t.%s = v.%s", c, c))
+	}
+
+	return strings.TrimSpace(fmt.Sprintf(
+		"%s DELETE FROM %s AS t USING %s WHERE %s RETURNING %s",
+		t.batchValues(n, columns, types),
+		table,
+		t.batchSource(columns, types),
+		strings.Join(clauses, " AND "),
+		strings.Join(qualified(returning...), ","),
+	))
+}
+
+// batchValues the common table expression of the values, arrays are bound
+// directly by the batch source.
+func (t Test) batchValues(n int, columns, types []string) string {
+	if t.Arrays {
+		return ""
+	}
+
+	values := make([]string, 0, n)
+	for i := range n {
+		p, _ := placeholders(i*len(columns)+1, selectPlaceholder(columns, nil))
+		values = append(values, fmt.Sprintf("(%s)", strings.Join(p, ",")))
+	}
+
+	return fmt.Sprintf("WITH v(%s) AS (VALUES %s)", strings.Join(columns, ","), strings.Join(values, ","))
+}
+
+// batchSource the source of the batch values, arrays are cast to the types of the columns.
+func (t Test) batchSource(columns, types []string) string {
+	if !t.Arrays {
+		return "v"
+	}
+
+	p, _ := placeholders(1, selectPlaceholder(columns, nil))
+	for idx, typ := range types {
+		p[idx] = fmt.Sprintf("%s::%s[]", p[idx], typ)
+	}
+
+	return fmt.Sprintf("unnest(%s) AS v(%s)", strings.Join(p, ", "), strings.Join(columns, ","))
+}
+
+// qualified qualifies the columns by the table alias of batch queries.
+func qualified(columns ...string) []string {
+	results := make([]string, 0, len(columns))
+	for _, c := range columns {
+		results = append(results, "t."+c)
+	}
+
+	return results
+}
+
+func (t Test) Conflict(target, updates []string) string {
+	if len(updates) == 0 {
+		return fmt.Sprintf("ON CONFLICT (%s) DO NOTHING", strings.Join(target, ","))
+	}
+
+	assignments := make([]string, 0, len(updates))
+	for _, c := range updates {
+		assignments = append(assignments, fmt.Sprintf("%s = EXCLUDED.%s", c, c))
+	}
+
+	return fmt.Sprintf("ON CONFLICT (%s) DO UPDATE SET %s", strings.Join(target, ","), strings.Join(assignments, ", "))
+}
+
+func (t Test) Paginate(table string, columns, keys []string, after bool) string {
+	if !after {
+		return fmt.Sprintf("SELECT %s FROM %s ORDER BY %s LIMIT $1", strings.Join(columns, ","), table, strings.Join(keys, ","))
+	}
+
+	placeholders := make([]string, 0, len(keys))
+	for idx := range keys {
+		placeholders = append(placeholders, fmt.Sprintf("$%d", idx+1))
+	}
+
+	return fmt.Sprintf(
+		"SELECT %s FROM %s WHERE (%s) > (%s) ORDER BY %s LIMIT $%d",
+		strings.Join(columns, ","), table, strings.Join(keys, ","), strings.Join(placeholders, ","), strings.Join(keys, ","), len(keys)+1,
+	)
+}
+
+func (t Test) Membership(placeholder string) string {
+	if !t.Arrays {
+		return ""
+	}
+
+	return fmt.Sprintf("= ANY(%s)", placeholder)
+}
+
+func (t Test) MaxParameters() int {
+	return t.Parameters
+}
+
+func (t Test) Returning() bool {
+	return !t.LastInsertID
 }
 
 func (t Test) ColumnValueTransformer() genieql.ColumnTransformer {
@@ -170,6 +350,23 @@ func (t Test) ColumnInformationForTable(d genieql.Driver, table string) ([]genie
 			{Name: "e", Definition: mustLookupType(d.LookupType("bool"))},
 			{Name: "f", Definition: mustLookupType(d.LookupType("bool"))},
 		}, nil
+	case "struct_snakecase":
+		return []genieql.ColumnInfo{
+			{Name: "uuid_field", Definition: mustLookupType(d.LookupType("string"))},
+			{Name: "created_at", Definition: mustLookupType(d.LookupType("int"))},
+		}, nil
+	case "struct_a_pk":
+		return []genieql.ColumnInfo{
+			{Name: "a", Definition: primaryKey(mustLookupType(d.LookupType("int")))},
+			{Name: "b", Definition: primaryKey(mustLookupType(d.LookupType("int")))},
+			{Name: "c", Definition: mustLookupType(d.LookupType("int"))},
+			{Name: "d", Definition: mustLookupType(d.LookupType("bool"))},
+		}, nil
+	case "struct_enum":
+		return []genieql.ColumnInfo{
+			{Name: "a", Definition: primaryKey(mustLookupType(d.LookupType("int")))},
+			{Name: "mood", Definition: enumeration(mustLookupType(d.LookupType("string")), "happy", "sad", "not_sure")},
+		}, nil
 	default:
 		return []genieql.ColumnInfo(nil), nil
 	}
@@ -179,10 +376,35 @@ func (t Test) ColumnInformationForQuery(d genieql.Driver, query string) ([]genie
 	return []genieql.ColumnInfo(nil), nil
 }
 
+func (t Test) ParameterInformationForQuery(d genieql.Driver, query string) ([]genieql.ColumnInfo, error) {
+	if t.ParameterTypes == nil {
+		return []genieql.ColumnInfo(nil), nil
+	}
+
+	return t.ParameterTypes(d, query)
+}
+
+func (t Test) Validate(query string) error {
+	if t.Validation == nil {
+		return nil
+	}
+
+	return t.Validation(query)
+}
+
 func (t Test) QuotedString(s string) string {
 	return t.Quote + s + t.Quote
 }
 
+func assignments(offset int, columns ...string) ([]string, int) {
+	clauses := make([]string, 0, len(columns))
+	for idx, column := range columns {
+		clauses = append(clauses, fmt.Sprintf("%s = $%d", column, offset+idx))
+	}
+
+	return clauses, offset + len(columns)
+}
+
 func placeholders(offset int, columns []placeholder) ([]string, int) {
 	clauses := make([]string, 0, len(columns))
 	idx := offset
@@ -233,3 +455,13 @@ type TestFactory Test
 func (t TestFactory) Connect(genieql.Configuration) (genieql.Dialect, error) {
 	return genieql.Dialect(Test(t)), nil
 }
+
+func primaryKey(d genieql.ColumnDefinition) genieql.ColumnDefinition {
+	d.PrimaryKey = true
+	return d
+}
+
+func enumeration(d genieql.ColumnDefinition, values ...string) genieql.ColumnDefinition {
+	d.Enum = values
+	return d
+}
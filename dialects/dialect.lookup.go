@@ -3,19 +3,71 @@
 package dialects
 
 import (
+	"errors"
+	"io/fs"
+	"log"
+
 	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/snapshot"
 )
 
-// LookupDialect lookup a registered dialect.
+// LookupDialect lookup a registered dialect. when the schema snapshot of the configuration
+// exists it is replayed instead of connecting to the database, see genieql.Configuration.SnapshotPath.
 func LookupDialect(config genieql.Configuration) (genieql.Dialect, error) {
 	var (
 		err     error
 		factory DialectFactory
+		s       snapshot.Snapshot
 	)
 
+	if s, err = snapshot.Read(config.SnapshotPath()); errors.Is(err, fs.ErrNotExist) {
+		return Connect(config)
+	} else if err != nil {
+		return nil, err
+	}
+
 	if factory, err = dialects.LookupDialect(config.Dialect); err != nil {
 		return nil, err
 	}
 
-	return factory.Connect(config)
+	offline, ok := factory.(OfflineFactory)
+	if !ok {
+		return nil, errorsx.Errorf("dialect (%s) is unable to replay the schema snapshot", config.Dialect)
+	}
+
+	// the snapshot describes the tables, the migrations are only consulted when recording it.
+	config.Migrations = ""
+	d, err := offline.Offline(config)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Println("replaying schema snapshot", config.SnapshotPath())
+
+	return snapshot.NewDialect(d, s), nil
+}
+
+// Connect to the database of the configuration, or describe the tables using its migrations.
+// the schema snapshot is ignored.
+func Connect(config genieql.Configuration) (genieql.Dialect, error) {
+	var (
+		err     error
+		factory DialectFactory
+	)
+
+	if factory, err = dialects.LookupDialect(config.Dialect); err != nil {
+		return nil, err
+	}
+
+	if config.Migrations == "" {
+		return factory.Connect(config)
+	}
+
+	offline, ok := factory.(OfflineFactory)
+	if !ok {
+		return nil, errorsx.Errorf("dialect (%s) is unable to generate from migrations", config.Dialect)
+	}
+
+	return offline.Offline(config)
 }
@@ -81,14 +81,18 @@ func LoadCustomColumnTypes(c Configuration, d Driver) (Driver, error) {
 
 // ColumnDefinition defines a type supported by the driver.
 type ColumnDefinition struct {
-	Type       string // dialect type
-	Native     string // golang type
-	DBTypeName string `yaml:"database_type_name"`
-	ColumnType string `yaml:"column_type"` // sql type
-	Nullable   bool   // does this type represent a pointer type.
-	PrimaryKey bool   // is the column part of the primary key
-	Decode     string // template function that decodes from the Driver type to Native type
-	Encode     string // template function that encodes from the Native type to Driver type
+	Type       string   // dialect type
+	Native     string   // golang type
+	DBTypeName string   `yaml:"database_type_name"`
+	ColumnType string   `yaml:"column_type"` // sql type
+	Nullable   bool     // does this type represent a pointer type.
+	PrimaryKey bool     // is the column part of the primary key
+	Decode     string   // template function that decodes from the Driver type to Native type
+	Encode     string   // template function that encodes from the Native type to Driver type
+	Override   string   `yaml:"override,omitempty"`  // golang type used in place of the Native type, must be convertible to and from the Native type.
+	Enum       []string `yaml:"enum,omitempty"`      // values of an enumerated type.
+	Default    string   `yaml:"default,omitempty"`   // expression providing the value when an insert omits the column, only reported for tables described by migrations.
+	Generated  bool     `yaml:"generated,omitempty"` // computed by the database, the column is read but never written.
 }
 
 type driverRegistry map[string]Driver
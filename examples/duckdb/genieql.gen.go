@@ -765,7 +765,7 @@ func Example1Insert(ctx context.Context, q sqlx.Queryer, e Example1) Example1Sca
 		c11 ducktype.NullTime     // timestamp_field
 		c12 ducktype.NullUint64   // ubigint_field
 		c13 sql.NullInt64         // uinteger_field
-		c14 sql.NullString
+		c14 sql.NullString        // uuid_field
 	)
 	c0.Valid = true
 	c0.Int64 = int64(e.BigintField)
@@ -802,7 +802,7 @@ func Example1Insert(ctx context.Context, q sqlx.Queryer, e Example1) Example1Sca
 	c13.Valid = true
 	c13.Int64 = int64(e.UintegerField)
 	c14.Valid = true
-	c14.String = e.UUIDField // uuid_field
+	c14.String = e.UUIDField
 	return NewExample1ScannerStaticRow(q.QueryRowContext(ctx, query, c0, c1, c2, c3, c4, c5, c6, c7, c8, c9, c10, c11, c12, c13, c14))
 }
 
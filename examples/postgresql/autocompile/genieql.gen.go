@@ -3427,7 +3427,7 @@ func Example1Insert(ctx context.Context, q sqlx.Queryer, e Example1) Example1Sca
 		c21 pgtype.Int2        // smallint_field
 		c22 pgtype.Text        // text_field
 		c23 pgtype.Timestamptz // timestamp_field
-		c24 pgtype.UUIDArray
+		c24 pgtype.UUIDArray   // uuid_array
 	)
 	if err := c0.Set(e.BigintField); err != nil {
 		return NewExample1ScannerStaticRow(nil).Err(err)
@@ -3514,7 +3514,7 @@ func Example1Insert(ctx context.Context, q sqlx.Queryer, e Example1) Example1Sca
 	}
 	if err := c24.Set(e.UUIDArray); err != nil {
 		return NewExample1ScannerStaticRow(nil).Err(err)
-	} // uuid_array
+	}
 	return NewExample1ScannerStaticRow(q.QueryRowContext(ctx, query, c0, c1, c2, c3, c4, c5, c6, c7, c8, c9, c10, c11, c12, c13, c14, c15, c16, c17, c18, c19, c20, c21, c22, c23, c24))
 }
 
@@ -3589,7 +3589,7 @@ func TimestampInsert(ctx context.Context, q sqlx.Queryer, e Timestamp) Timestamp
 		c0 pgtype.Timestamptz // timestamp
 		c1 pgtype.Timestamptz // timestamptz
 		c2 pgtype.Timestamptz // timestamptz_nullable
-		c3 pgtype.UUID
+		c3 pgtype.UUID        // uuid
 	)
 	switch e.Timestamp {
 	case time.Unix(math.MaxInt64-62135596800, 999999999):
@@ -3635,7 +3635,7 @@ func TimestampInsert(ctx context.Context, q sqlx.Queryer, e Timestamp) Timestamp
 	}
 	if err := c3.Set(e.UUID); err != nil {
 		return NewTimestampScannerStaticRow(nil).Err(err)
-	} // uuid
+	}
 	return NewTimestampScannerStaticRow(q.QueryRowContext(ctx, query, c0, c1, c2, c3))
 }
 
@@ -3711,7 +3711,7 @@ func ConflictInsert(ctx context.Context, q sqlx.Queryer, e Timestamp) TimestampS
 		c0 pgtype.Timestamptz // timestamp
 		c1 pgtype.Timestamptz // timestamptz
 		c2 pgtype.Timestamptz // timestamptz_nullable
-		c3 pgtype.UUID
+		c3 pgtype.UUID        // uuid
 	)
 	switch e.Timestamp {
 	case time.Unix(math.MaxInt64-62135596800, 999999999):
@@ -3757,6 +3757,6 @@ func ConflictInsert(ctx context.Context, q sqlx.Queryer, e Timestamp) TimestampS
 	}
 	if err := c3.Set(e.UUID); err != nil {
 		return NewTimestampScannerStaticRow(nil).Err(err)
-	} // uuid
+	}
 	return NewTimestampScannerStaticRow(q.QueryRowContext(ctx, query, c0, c1, c2, c3))
 }
@@ -0,0 +1,16 @@
+package example
+
+import "time"
+
+// Tagged generated by genieql
+type Tagged struct {
+	Field1        string     `json:"field1" field:"Field1"`
+	Field2        *string    `json:"field2" field:"Field2"`
+	Field3        bool       `json:"field3" field:"Field3"`
+	Field4        *bool      `json:"field4" field:"Field4"`
+	Field5        int        `json:"field5" field:"Field5"`
+	Field6        *int       `json:"field6" field:"Field6"`
+	Field7        time.Time  `json:"field7" field:"Field7"`
+	Field8        *time.Time `json:"field8" field:"Field8"`
+	Unmappedfield int        `json:"unmappedfield" field:"Unmappedfield"`
+}
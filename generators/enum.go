@@ -0,0 +1,78 @@
+package generators
+
+import (
+	"go/ast"
+	"io"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/serenize/snaker"
+
+	"github.com/james-lawrence/genieql"
+)
+
+// NewEnum creates a Generator that builds a named string type from the values
+// of an enumerated type. the type implements sql.Scanner and driver.Valuer.
+func NewEnum(name string, comment *ast.CommentGroup, values ...string) genieql.Generator {
+	return enum{
+		Name:    name,
+		Comment: comment,
+		Values:  values,
+	}
+}
+
+type enum struct {
+	Name    string
+	Comment *ast.CommentGroup
+	Values  []string
+}
+
+func (t enum) Generate(dst io.Writer) (err error) {
+	const tmpl = `type {{.Name}} string
+
+const (
+	{{- range $value := .Values }}
+	{{ $.Name }}{{ $value | constant }} {{ $.Name }} = {{ $value | printf "%q" }}
+	{{- end }}
+)
+
+// Scan implements sql.Scanner.
+func (t *{{.Name}}) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		*t = {{.Name}}(v)
+	case []byte:
+		*t = {{.Name}}(v)
+	default:
+		return fmt.Errorf("unable to scan %T into {{.Name}}", src)
+	}
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (t {{.Name}}) Value() (driver.Value, error) {
+	return string(t), nil
+}
+`
+
+	if err = GenerateComment(DefaultTypeComment(t.Name), t.Comment).Generate(dst); err != nil {
+		return err
+	}
+
+	return template.Must(template.New("enum template").Funcs(template.FuncMap{
+		"constant": enumConstant,
+	}).Parse(tmpl)).Execute(dst, t)
+}
+
+// enumConstant converts an enumerated value into a valid identifier suffix.
+func enumConstant(s string) string {
+	return snaker.SnakeToCamel(strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+
+		return '_'
+	}, s))
+}
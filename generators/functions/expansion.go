@@ -0,0 +1,407 @@
+package functions
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/generators"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+)
+
+// matches the parameters of a query. i.e.) {a}, {a.b}, {ids...}
+var parameterPattern = regexp.MustCompile(`\{([\w.]*\w)(\.\.\.)?\}`)
+
+// ExpandedParams returns the names of the parameters the query expands. i.e.) {ids...}
+func ExpandedParams(q string) (names []string) {
+	for _, m := range parameterPattern.FindAllStringSubmatch(q, -1) {
+		if m[2] == "" || slices.Contains(names, m[1]) {
+			continue
+		}
+
+		names = append(names, m[1])
+	}
+
+	return names
+}
+
+// ExpandArrays rewrites the expanded parameters of the query to bind the values as a single array.
+// i.e.) id IN ({ids...}) becomes id = ANY({ids}) for postgresql.
+// returns false when the dialect is unable to bind arrays.
+func ExpandArrays(d genieql.Dialect, q string, names ...string) (string, bool) {
+	for _, name := range names {
+		param := fmt.Sprintf("{%s}", name)
+		clause := d.Membership(param)
+		if clause == "" {
+			return q, false
+		}
+
+		in := regexp.MustCompile(`(?i)\bIN\s*\(\s*\{` + regexp.QuoteMeta(name) + `\.\.\.\}\s*\)`)
+		q = in.ReplaceAllLiteralString(q, clause)
+		q = strings.ReplaceAll(q, fmt.Sprintf("{%s...}", name), param)
+	}
+
+	return q, true
+}
+
+// ExpandRuntime generates the statements that build the query and its arguments at runtime.
+// used for dialects that are unable to bind arrays and for queries with optional clauses.
+// the placeholders are renumbered as the values are appended. the statements declare the
+// query and args locals. inputs are the query inputs of the columns.
+//
+// optional clauses are dropped when any of the parameters they reference are nil pointers or
+// empty slices. i.e.) WHERE 't' {? AND status = {status}}
+// the values of expanded parameters are encoded individually, empty slices expand to NULL
+// which matches no rows. i.e.) id IN (NULL)
+func ExpandRuntime(ctx generators.Context, errHandler func(string) ast.Node, q string, params []*ast.Field, columns []genieql.ColumnMap, inputs []ast.Expr) (stmts []ast.Stmt, err error) {
+	b := runtimeQuery{
+		ctx:        ctx,
+		errHandler: errHandler,
+		format:     placeholderFormat(ctx.Dialect),
+		params:     params,
+		columns:    columns,
+		inputs:     inputs,
+		query:      ast.NewIdent(defaultQuery),
+		args:       ast.NewIdent("args"),
+	}
+
+	stmts = append(stmts, astutil.DeclStmt(astutil.VarList(
+		astutil.ValueSpec(ast.NewIdent("string"), b.query),
+		astutil.ValueSpec(astutil.Expr("[]any"), b.args),
+	)))
+
+	if b.stmts, err = b.build(q); err != nil {
+		return nil, err
+	}
+
+	// initialize the query with the leading literal.
+	if len(b.stmts) > 0 {
+		if assign, ok := b.stmts[0].(*ast.AssignStmt); ok && assign.Tok == token.ADD_ASSIGN {
+			assign.Tok = token.ASSIGN
+		}
+	}
+
+	return append(stmts, b.stmts...), nil
+}
+
+// HasOptional detects if the query has optional clauses. i.e.) {? AND status = {status}}
+func HasOptional(q string) bool {
+	return strings.Contains(q, "{?")
+}
+
+// OptionalParams returns the names of the parameters referenced by the optional clauses of the query.
+// i.e.) {? AND status = {status.value}} references status
+func OptionalParams(q string) (names []string) {
+	for start := strings.Index(q, "{?"); start >= 0; start = strings.Index(q, "{?") {
+		q = q[start:]
+		end := closingBrace(q)
+		if end < 0 {
+			end = len(q) - 1
+		}
+
+		for _, m := range parameterPattern.FindAllStringSubmatch(q[:end+1], -1) {
+			if name, _, _ := strings.Cut(m[1], "."); !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+
+		q = q[end+1:]
+	}
+
+	return names
+}
+
+type runtimeQuery struct {
+	ctx        generators.Context
+	errHandler func(string) ast.Node
+	format     string
+	params     []*ast.Field
+	columns    []genieql.ColumnMap
+	inputs     []ast.Expr
+	query      *ast.Ident
+	args       *ast.Ident
+	stmts      []ast.Stmt
+	guarded    []string // expanded parameters known to be non-empty.
+}
+
+func (t runtimeQuery) build(q string) (stmts []ast.Stmt, err error) {
+	var (
+		literal strings.Builder
+	)
+
+	flush := func() {
+		if literal.Len() == 0 {
+			return
+		}
+
+		stmts = append(stmts, t.concat(astutil.StringLiteral(literal.String())))
+		literal.Reset()
+	}
+
+	for len(q) > 0 {
+		start := strings.IndexByte(q, '{')
+		if start < 0 {
+			literal.WriteString(q)
+			break
+		}
+
+		literal.WriteString(q[:start])
+		q = q[start:]
+
+		if strings.HasPrefix(q, "{?") {
+			var (
+				end      int
+				fragment []ast.Stmt
+				cond     ast.Expr
+			)
+
+			if end = closingBrace(q); end < 0 {
+				return nil, errorsx.Errorf("unterminated optional clause: %s", q)
+			}
+
+			if cond, err = t.condition(q[2:end]); err != nil {
+				return nil, err
+			}
+
+			// the clause is only included when its slices are non-empty.
+			inner := t
+			inner.guarded = append(slices.Clone(t.guarded), ExpandedParams(q[2:end])...)
+			if fragment, err = inner.build(q[2:end]); err != nil {
+				return nil, err
+			}
+
+			flush()
+			stmts = append(stmts, astutil.If(nil, cond, astutil.Block(fragment...), nil))
+			q = q[end+1:]
+			continue
+		}
+
+		m := parameterPattern.FindStringSubmatchIndex(q)
+		if m == nil || m[0] != 0 {
+			literal.WriteByte('{')
+			q = q[1:]
+			continue
+		}
+
+		name := q[m[2]:m[3]]
+
+		// expanded parameters append each value as an argument.
+		if m[4] != -1 {
+			var expanded []ast.Stmt
+
+			if expanded, err = t.expand(name); err != nil {
+				return nil, err
+			}
+
+			flush()
+			stmts = append(stmts, expanded...)
+			q = q[m[1]:]
+			continue
+		}
+
+		x := t.input(name)
+		if x == nil {
+			// not a parameter, leave it untouched.
+			literal.WriteString(q[:m[1]])
+			q = q[m[1]:]
+			continue
+		}
+
+		flush()
+		stmts = append(stmts, t.append(x), t.concat(t.placeholderExpr()))
+		q = q[m[1]:]
+	}
+
+	flush()
+
+	return stmts, nil
+}
+
+// expand generates the statements appending each value of the slice parameter as an argument.
+func (t runtimeQuery) expand(name string) (stmts []ast.Stmt, err error) {
+	var (
+		cmaps     []genieql.ColumnMap
+		encodings []ast.Stmt
+		value     ast.Expr = ast.NewIdent("v")
+	)
+
+	idx := slices.IndexFunc(t.params, func(f *ast.Field) bool { return f.Names[0].Name == name })
+	if idx < 0 {
+		return nil, errorsx.Errorf("expanded parameter %s does not exist", name)
+	}
+
+	typ, ok := t.params[idx].Type.(*ast.ArrayType)
+	if !ok || typ.Len != nil {
+		return nil, errorsx.Errorf("expanded parameter %s must be a slice", name)
+	}
+
+	if cmaps, err = generators.ColumnMapFromFields(t.ctx, astutil.Field(typ.Elt, ast.NewIdent("v"))); err != nil {
+		return nil, errorsx.Wrapf(err, "unable to map the values of expanded parameter %s", name)
+	}
+
+	if len(cmaps) != 1 {
+		return nil, errorsx.Errorf("expanded parameter %s must be a slice of a single column type", name)
+	}
+
+	// the local is numbered after the locals of the columns to avoid shadowing them.
+	local := cmaps[0].Local(len(t.columns) + idx)
+	if encodings, err = generators.ColumnMapEncoder(t.ctx)(len(t.columns)+idx, cmaps[0], t.errHandler); err != nil {
+		return nil, errorsx.Wrapf(err, "unable to encode the values of expanded parameter %s", name)
+	}
+
+	body := []ast.Stmt{
+		astutil.If(
+			nil,
+			astutil.BinaryExpr(ast.NewIdent("idx"), token.GTR, astutil.IntegerLiteral(0)),
+			astutil.Block(t.concat(astutil.StringLiteral(","))),
+			nil,
+		),
+	}
+
+	if encodings != nil {
+		body = append(body, astutil.DeclStmt(astutil.VarList(
+			astutil.ValueSpec(astutil.MustParseExpr(t.ctx.FileSet, cmaps[0].Definition.ColumnType), local),
+		)))
+		body = append(body, encodings...)
+		value = local
+	}
+
+	body = append(body, t.append(value), t.concat(t.placeholderExpr()))
+
+	expanded := astutil.Range(
+		ast.NewIdent("idx"),
+		ast.NewIdent("v"),
+		token.DEFINE,
+		ast.NewIdent(name),
+		astutil.Block(body...),
+	)
+
+	if slices.Contains(t.guarded, name) {
+		return []ast.Stmt{expanded}, nil
+	}
+
+	return []ast.Stmt{
+		// empty slices would generate invalid sql, i.e.) IN ()
+		astutil.If(
+			nil,
+			astutil.BinaryExpr(astutil.CallExpr(ast.NewIdent("len"), ast.NewIdent(name)), token.EQL, astutil.IntegerLiteral(0)),
+			astutil.Block(t.concat(astutil.StringLiteral("NULL"))),
+			nil,
+		),
+		expanded,
+	}, nil
+}
+
+// condition generates the expression determining if an optional clause is included.
+func (t runtimeQuery) condition(fragment string) (cond ast.Expr, err error) {
+	var (
+		seen []string
+	)
+
+	for _, m := range parameterPattern.FindAllStringSubmatch(fragment, -1) {
+		name, _, _ := strings.Cut(m[1], ".")
+		if slices.Contains(seen, name) {
+			continue
+		}
+		seen = append(seen, name)
+
+		idx := slices.IndexFunc(t.params, func(f *ast.Field) bool { return f.Names[0].Name == name })
+		if idx < 0 {
+			continue
+		}
+
+		var x ast.Expr
+		switch typ := t.params[idx].Type.(type) {
+		case *ast.StarExpr:
+			x = astutil.BinaryExpr(ast.NewIdent(name), token.NEQ, ast.NewIdent("nil"))
+		case *ast.ArrayType:
+			if typ.Len != nil {
+				return nil, errorsx.Errorf("optional parameter %s must be a pointer or a slice", name)
+			}
+			x = astutil.BinaryExpr(astutil.CallExpr(ast.NewIdent("len"), ast.NewIdent(name)), token.GTR, astutil.IntegerLiteral(0))
+		default:
+			return nil, errorsx.Errorf("optional parameter %s must be a pointer or a slice", name)
+		}
+
+		if cond == nil {
+			cond = x
+		} else {
+			cond = astutil.BinaryExpr(cond, token.LAND, x)
+		}
+	}
+
+	if cond == nil {
+		return nil, errorsx.Errorf("optional clause must reference a parameter: %s", fragment)
+	}
+
+	return cond, nil
+}
+
+func (t runtimeQuery) input(name string) ast.Expr {
+	for idx, c := range t.columns {
+		if types.ExprString(astutil.DereferencedIdent(c.Dst)) == name {
+			return t.inputs[idx]
+		}
+	}
+
+	return nil
+}
+
+func (t runtimeQuery) concat(x ast.Expr) ast.Stmt {
+	return astutil.Assign(astutil.ExprList(t.query), token.ADD_ASSIGN, astutil.ExprList(x))
+}
+
+func (t runtimeQuery) append(x ast.Expr) ast.Stmt {
+	return astutil.Assign(
+		astutil.ExprList(t.args),
+		token.ASSIGN,
+		astutil.ExprList(astutil.CallExpr(ast.NewIdent("append"), t.args, x)),
+	)
+}
+
+func (t runtimeQuery) placeholderExpr() ast.Expr {
+	if !strings.Contains(t.format, "%d") {
+		return astutil.StringLiteral(t.format)
+	}
+
+	return astutil.CallExpr(
+		astutil.SelExpr("fmt", "Sprintf"),
+		astutil.StringLiteral(t.format),
+		astutil.CallExpr(ast.NewIdent("len"), t.args),
+	)
+}
+
+// closingBrace returns the index of the brace closing the one at the start of s.
+func closingBrace(s string) int {
+	depth := 0
+	for idx, r := range s {
+		switch r {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return idx
+			}
+		}
+	}
+
+	return -1
+}
+
+// placeholderFormat derives the format of a placeholder from the dialect.
+// numbered placeholders have the offset replaced with a verb. i.e.) $1 becomes $%d
+func placeholderFormat(d genieql.Dialect) string {
+	p := d.ColumnValueTransformer().Transform(genieql.ColumnInfo{})
+	if strings.HasSuffix(p, "1") {
+		return strings.TrimSuffix(p, "1") + "%d"
+	}
+
+	return p
+}
@@ -24,6 +24,8 @@ const (
 var queryRecordsPattern = astutil.TypePattern(astutil.ExprTemplateList("*sql.Rows", "error")...)
 var queryUniquePattern = astutil.TypePattern(astutil.Expr("*sql.Row"))
 var contextPattern = astutil.TypePattern(astutil.Expr("context.Context"))
+var execResultPattern = astutil.TypePattern(astutil.ExprTemplateList("sql.Result", "error")...)
+var execAffectedPattern = astutil.TypePattern(astutil.ExprTemplateList("int64", "error")...)
 
 // DetectScanner - extracts the scanner from the function definition.
 // by convention the scanner is the first field in the result type.
@@ -94,13 +96,14 @@ type compiler interface {
 // Query function compiler
 type Query struct {
 	generators.Context
-	Query           ast.Expr
+	Query           ast.Expr   // when nil the transforms are responsible for declaring the query.
 	ContextField    *ast.Field // is there a context field
 	Queryer         ast.Expr   // the type of the queryer
 	QueryerFunction *ast.Ident
 	Scanner         *ast.FuncDecl
 	Transforms      []ast.Stmt
 	QueryInputs     []ast.Expr
+	Variadic        bool // the last query input is variadic. i.e.) args...
 }
 
 func SanitizeQueryIdents(i *ast.Ident) *ast.Ident {
@@ -112,6 +115,16 @@ func SanitizeQueryIdents(i *ast.Ident) *ast.Ident {
 	return i
 }
 
+// SanitizeExecIdents prevents collisions with the locals of exec functions.
+func SanitizeExecIdents(i *ast.Ident) *ast.Ident {
+	switch i.Name {
+	case "result", "err":
+		return ast.NewIdent("_genieql_" + i.Name)
+	}
+
+	return SanitizeQueryIdents(i)
+}
+
 func QueryInputsFromFields(inputs ...*ast.Field) (output []ast.Expr) {
 	for _, i := range inputs {
 		output = append(output, astutil.MapFieldsToNameExpr(i)...)
@@ -226,18 +239,24 @@ func (t Query) Compile(d Definition) (_ *ast.FuncDecl, err error) {
 	)
 	d.Signature.Results = t.Scanner.Type.Results
 
-	stmts := []ast.Stmt{
-		astutil.ConstDecl(types.ExprString(query), t.Query),
+	stmts := []ast.Stmt{}
+	if t.Query != nil {
+		stmts = append(stmts, astutil.ConstDecl(types.ExprString(query), t.Query))
 	}
 
 	if len(t.Transforms) > 0 {
 		stmts = append(stmts, t.Transforms...)
 	}
 
+	call := astutil.CallExpr
+	if t.Variadic {
+		call = astutil.CallExprEllipsis
+	}
+
 	stmts = append(stmts, astutil.Return(
 		astutil.CallExpr(
 			t.Scanner.Name,
-			astutil.CallExpr(
+			call(
 				astutil.SelExpr(queryerIdent.Name, t.QueryerFunction.Name),
 				qinputs...,
 			),
@@ -247,6 +266,123 @@ func (t Query) Compile(d Definition) (_ *ast.FuncDecl, err error) {
 	return combine(d, astutil.Block(stmts...)), nil
 }
 
+// DetectExec - detects if the function executes a statement without returning rows.
+// i.e.) the results are (sql.Result, error) or (int64, error) for the rows affected.
+func DetectExec(fnt *ast.FuncType) bool {
+	if fnt.Results == nil {
+		return false
+	}
+
+	pattern := resultTypes(fnt.Results)
+	return execResultPattern(pattern...) || execAffectedPattern(pattern...)
+}
+
+// resultTypes extracts the types of the results without naming them.
+func resultTypes(results *ast.FieldList) (r []ast.Expr) {
+	for _, f := range results.List {
+		r = append(r, f.Type)
+		for range max(len(f.Names)-1, 0) {
+			r = append(r, f.Type)
+		}
+	}
+
+	return r
+}
+
+// ExecErrorHandling returns the zero value of the results along with the error.
+func ExecErrorHandling(results *ast.FieldList) func(local string) ast.Node {
+	zero := ast.Expr(ast.NewIdent("nil"))
+	if execAffectedPattern(resultTypes(results)...) {
+		zero = astutil.IntegerLiteral(0)
+	}
+
+	return func(local string) ast.Node {
+		return astutil.Return(zero, ast.NewIdent(local))
+	}
+}
+
+// Exec function compiler for statements that do not return rows.
+// the results of the definition determine if the sql.Result or the rows affected are returned.
+type Exec struct {
+	generators.Context
+	Query        ast.Expr   // when nil the transforms are responsible for declaring the query.
+	ContextField *ast.Field // is there a context field
+	Queryer      ast.Expr   // the type of the queryer
+	Transforms   []ast.Stmt
+	QueryInputs  []ast.Expr
+	Variadic     bool // the last query input is variadic. i.e.) args...
+}
+
+// Compile using the provided definition.
+func (t Exec) Compile(d Definition) (_ *ast.FuncDecl, err error) {
+	var (
+		query        = astutil.Expr(defaultQuery)
+		queryerIdent = ast.NewIdent(defaultQueryParamName)
+		result       = ast.NewIdent("result")
+	)
+
+	if !DetectExec(d.Signature) {
+		return nil, errorsx.Errorf("exec functions must return (sql.Result, error) or (int64, error)")
+	}
+
+	// prevent name collisions.
+	d.Signature.Params.List = generators.SanitizeFieldIdents(SanitizeExecIdents, d.Signature.Params.List...)
+
+	// setup function arguments.
+	finputs := []*ast.Field{astutil.Field(t.Queryer, queryerIdent)}
+	qinputs := []ast.Expr{astutil.Expr("context.Background()")}
+	if t.ContextField != nil {
+		finputs = []*ast.Field{t.ContextField, astutil.Field(t.Queryer, queryerIdent)}
+		qinputs = astutil.MapFieldsToNameExpr(t.ContextField)
+	}
+
+	qinputs = append(qinputs, query)
+	if len(t.QueryInputs) == 0 {
+		qinputs = append(qinputs, QueryInputsFromFields(d.Signature.Params.List...)...)
+	} else {
+		qinputs = append(qinputs, t.QueryInputs...)
+	}
+
+	d.Signature.Params.List = append(
+		finputs,
+		d.Signature.Params.List...,
+	)
+
+	stmts := []ast.Stmt{}
+	if t.Query != nil {
+		stmts = append(stmts, astutil.ConstDecl(types.ExprString(query), t.Query))
+	}
+
+	if len(t.Transforms) > 0 {
+		stmts = append(stmts, t.Transforms...)
+	}
+
+	call := astutil.CallExpr
+	if t.Variadic {
+		call = astutil.CallExprEllipsis
+	}
+	exec := call(astutil.SelExpr(queryerIdent.Name, "ExecContext"), qinputs...)
+
+	if execResultPattern(resultTypes(d.Signature.Results)...) {
+		stmts = append(stmts, astutil.Return(exec))
+		return combine(d, astutil.Block(stmts...)), nil
+	}
+
+	stmts = append(
+		stmts,
+		astutil.Assign(astutil.ExprList(result, ast.NewIdent("err")), token.DEFINE, astutil.ExprList(exec)),
+		astutil.If(
+			nil,
+			astutil.BinaryExpr(ast.NewIdent("err"), token.NEQ, ast.NewIdent("nil")),
+			astutil.Block(astutil.Return(astutil.IntegerLiteral(0), ast.NewIdent("err"))),
+			nil,
+		),
+		astutil.Return(astutil.CallExpr(astutil.SelExpr(result.Name, "RowsAffected"))),
+	)
+
+	return combine(d, astutil.Block(stmts...)), nil
+}
+
 func NewFn(body ...ast.Stmt) Body {
 	return Body(body)
 }
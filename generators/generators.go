@@ -146,6 +146,15 @@ func GapLines(dst io.Writer, n int) (err error) {
 	return nil
 }
 
+// DefaultTypeComment comment for generated types.
+func DefaultTypeComment(name string) *ast.CommentGroup {
+	return &ast.CommentGroup{
+		List: []*ast.Comment{
+			{Text: fmt.Sprintf("// %s type generated by genieql", name)},
+		},
+	}
+}
+
 func DefaultFunctionComment(name string) *ast.CommentGroup {
 	return &ast.CommentGroup{
 		List: []*ast.Comment{
@@ -202,6 +211,13 @@ func OptionVerbosity(n int) Option {
 	}
 }
 
+// OptionDialect replaces the dialect of the configuration. i.e.) recording the schema snapshot.
+func OptionDialect(d genieql.Dialect) Option {
+	return func(ctx *Context) {
+		ctx.Dialect = d
+	}
+}
+
 func NewContext(bctx build.Context, name string, pkg *build.Package, options ...Option) (ctx Context, err error) {
 	var config genieql.Configuration
 	config, err = genieql.NewConfiguration(
@@ -331,6 +347,13 @@ func ScannerErrorHandlingExpr(scanner *ast.FuncDecl, additional ...ast.Expr) fun
 }
 
 func QueryInputsFromColumnMap(ctx Context, scanner *ast.FuncDecl, errHandler func(string) ast.Node, cmaps ...genieql.ColumnMap) (locals []ast.Spec, encodings []ast.Stmt, qinputs []ast.Expr, err error) {
+	return GuardedQueryInputsFromColumnMap(ctx, scanner, errHandler, nil, cmaps...)
+}
+
+// GuardedQueryInputsFromColumnMap generates the query inputs wrapping the encoding of each column
+// in the condition returned by the guard. i.e.) to prevent dereferencing nil pointers.
+// the guard returns nil when the encoding is unconditional.
+func GuardedQueryInputsFromColumnMap(ctx Context, scanner *ast.FuncDecl, errHandler func(string) ast.Node, guard func(genieql.ColumnMap) ast.Expr, cmaps ...genieql.ColumnMap) (locals []ast.Spec, encodings []ast.Stmt, qinputs []ast.Expr, err error) {
 	if errHandler == nil {
 		errHandler = ScannerErrorHandling(scanner)
 	}
@@ -352,6 +375,12 @@ func QueryInputsFromColumnMap(ctx Context, scanner *ast.FuncDecl, errHandler fun
 			continue
 		}
 
+		if guard != nil {
+			if cond := guard(cmap); cond != nil {
+				tmp = []ast.Stmt{astutil.If(nil, cond, astutil.Block(tmp...), nil)}
+			}
+		}
+
 		qinputs = append(qinputs, local)
 		encodings = append(encodings, tmp...)
 
@@ -37,6 +37,9 @@ const (
 	ModeStaticDisableColumns
 	// ModeDynamic - output the dynamic scanner.
 	ModeDynamic
+	// ModeIterator - output the range over func iterator built on the static scanner.
+	// only supported by scanners with a single parameter.
+	ModeIterator
 )
 
 // ScannerOption option to provide the structure function.
@@ -232,6 +235,7 @@ func (t scanner) Generate(dst io.Writer) error {
 		InterfaceName string
 		Parameters    []*ast.Field
 		Columns       []genieql.ColumnMap
+		Type          ast.Expr
 	}
 
 	ctx := context{
@@ -310,6 +314,27 @@ func (t scanner) Generate(dst io.Writer) error {
 		}
 	}
 
+	if t.Mode.Enabled(ModeIterator) {
+		params := astutil.FlattenFields(t.Fields.List...)
+		if len(params) != 1 {
+			return errorsx.Errorf("%s - iterator scanners require exactly one parameter", t.Name)
+		}
+
+		if t.Mode.Disabled(ModeStatic) {
+			return errorsx.Errorf("%s - iterator scanners require the static scanner", t.Name)
+		}
+
+		ctx.Type = params[0].Type
+		tmpl = template.Must(template.New("iterator").Funcs(funcMap).Parse(iteratorScanner))
+		if err = tmpl.Execute(dst, ctx); err != nil {
+			return errorsx.Wrap(err, "failed to generate iterator scanner")
+		}
+
+		if _, err = dst.Write([]byte("\n")); err != nil {
+			return errorsx.Wrap(err, "failed to write newline")
+		}
+	}
+
 	if t.Mode.Enabled(ModeDynamic) {
 		tmpl = template.Must(template.New("dynamic").Funcs(funcMap).Parse(dynamicScanner))
 		if err = tmpl.Execute(dst, ctx); err != nil {
@@ -463,6 +488,47 @@ func (t {{.Name | title}}StaticRow) Err(err error) {{.Name | title}}StaticRow {
 }
 `
 
+const iteratorScanner = `// New{{.Name | title}}Iter creates an iterator over the rows using the static
+// scanner. the rows are closed once iteration completes.
+func New{{.Name | title}}Iter(rows *sql.Rows, err error) iter.Seq2[{{ .Type | expr }}, error] {
+	return func(yield func({{ .Type | expr }}, error) bool) {
+		scanner := New{{.Name | title}}Static(rows, err)
+		defer scanner.Close()
+
+		for scanner.Next() {
+			var v {{ .Type | expr }}
+
+			if err := scanner.Scan(&v); err != nil {
+				yield(v, err)
+				return
+			}
+
+			if !yield(v, nil) {
+				return
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			var v {{ .Type | expr }}
+			yield(v, err)
+		}
+	}
+}
+
+// {{.Name | title}}Collect scans all the rows returning the first error encountered.
+func {{.Name | title}}Collect(rows *sql.Rows, err error) (results []{{ .Type | expr }}, _ error) {
+	for v, err := range New{{.Name | title}}Iter(rows, err) {
+		if err != nil {
+			return results, err
+		}
+
+		results = append(results, v)
+	}
+
+	return results, nil
+}
+`
+
 const dynamicScanner = `
 // New{{.Name | title}}Dynamic creates a scanner that operates on a dynamic
 // set of columns that can be returned in any subset/order.
@@ -1,12 +1,17 @@
 package generators
 
 import (
+	"bytes"
+	"fmt"
 	"go/ast"
-	"html/template"
 	"io"
+	"strings"
+	"text/template"
 
 	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/internal/errorsx"
 	"github.com/james-lawrence/genieql/internal/transformx"
+	"golang.org/x/text/transform"
 )
 
 // StructOption option to provide the structure function.
@@ -78,6 +83,15 @@ func StructOptionMappingConfigOptions(options ...genieql.MappingConfigOption) St
 	}
 }
 
+// StructOptionTags templates used to generate the struct tags of each field.
+// templates are executed with the Column and Field name of the field.
+// i.e.) `json:"{{ .Column.Name }},omitempty"`
+func StructOptionTags(tags ...string) StructOption {
+	return func(s *structure) {
+		s.tags = tags
+	}
+}
+
 // NewStructure creates a Generator that builds structures from column information.
 func NewStructure(opts ...StructOption) genieql.Generator {
 	s := structure{
@@ -102,12 +116,13 @@ type structure struct {
 	aliaser        genieql.MappingConfigOption
 	renameMap      genieql.MappingConfigOption
 	mappingOptions []genieql.MappingConfigOption
+	tags           []string
 }
 
 func (t structure) Generate(dst io.Writer) error {
 	const tmpl = `type {{.Name}} struct {
 	{{- range $column := .Columns }}
-	{{ $column.Name | transformation }} {{ if $column.Definition.Nullable }}*{{ end }}{{ $column.Definition.Native -}}
+	{{ $column.Name | transformation }} {{ if $column.Definition.Nullable }}*{{ end }}{{ or $column.Definition.Override $column.Definition.Native }}{{ $column | tags -}}
 	{{ end }}
 }`
 	type context struct {
@@ -149,7 +164,46 @@ func (t structure) Generate(dst io.Writer) error {
 
 	a := mapping.Aliaser()
 
+	tags := make([]*template.Template, 0, len(t.tags))
+	for _, tag := range t.tags {
+		parsed, err := template.New("tag").Parse(tag)
+		if err != nil {
+			return errorsx.Wrapf(err, "invalid struct tag template: %s", tag)
+		}
+		tags = append(tags, parsed)
+	}
+
 	return template.Must(template.New("scanner template").Funcs(template.FuncMap{
 		"transformation": func(s string) string { return transformx.String(s, a) },
+		"tags":           t.tagsfn(tags, a),
 	}).Parse(tmpl)).Execute(dst, ctx)
 }
+
+// tagsfn renders the struct tags of a column.
+func (t structure) tagsfn(tags []*template.Template, a transform.Transformer) func(genieql.ColumnInfo) (string, error) {
+	type tagctx struct {
+		Column genieql.ColumnInfo
+		Field  string
+	}
+
+	return func(c genieql.ColumnInfo) (string, error) {
+		rendered := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			var (
+				buf bytes.Buffer
+			)
+
+			if err := tag.Execute(&buf, tagctx{Column: c, Field: transformx.String(c.Name, a)}); err != nil {
+				return "", errorsx.Wrapf(err, "failed to generate struct tag for column: %s", c.Name)
+			}
+
+			rendered = append(rendered, buf.String())
+		}
+
+		if len(rendered) == 0 {
+			return "", nil
+		}
+
+		return fmt.Sprintf(" `%s`", strings.Join(rendered, " ")), nil
+	}
+}
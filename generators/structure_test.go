@@ -75,5 +75,12 @@ var _ = ginkgo.Describe("Structure", func() {
 			}),
 			StructOptionAliasStrategy(genieql.MCOTransformations("lowercase")),
 		),
+		ginkgo.Entry(
+			"type1 structure with tags",
+			".fixtures/structures/type1_tags.go",
+			StructOptionTableStrategy("type1"),
+			StructOptionName("Tagged"),
+			StructOptionTags(`json:"{{ .Column.Name }}"`, `field:"{{ .Field }}"`),
+		),
 	)
 })
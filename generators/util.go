@@ -116,14 +116,16 @@ func decode(ctx Context) func(int, genieql.ColumnMap, func(string) ast.Node) ([]
 		}
 
 		var (
-			local = column.Local(i)
-			gen   *ast.FuncLit
+			local    = column.Local(i)
+			gen      *ast.FuncLit
+			override = column.Definition.Override
 		)
 
 		if column.Definition.Decode == "" {
 			if column.Definition, err = lookupTypeDefinition(column.Definition.Type); err != nil {
 				return nil, errorsx.Wrapf(err, "invalid type definition: %s", spew.Sdump(column.Definition))
 			}
+			column.Definition.Override = override
 		}
 
 		typex := astutil.MustParseExpr(ctx.FileSet, column.Definition.Native)
@@ -132,6 +134,17 @@ func decode(ctx Context) func(int, genieql.ColumnMap, func(string) ast.Node) ([]
 			to = &ast.StarExpr{X: astutil.UnwrapExpr(to)}
 		}
 
+		if override != "" {
+			if gen, err = decodeOverride(ctx, column, local, to, errHandler); err != nil {
+				return nil, err
+			}
+
+			// decoders that assign indirectly (i.e. reflection) handle the override type natively.
+			if gen != nil {
+				return gen.Body.List, nil
+			}
+		}
+
 		if gen, err = genFunctionLiteral(ctx, column.Definition.Decode, stmtCtx{Type: astutil.UnwrapExpr(typex), From: local, To: to, Column: column}, errHandler); err != nil {
 			return nil, err
 		}
@@ -140,6 +153,73 @@ func decode(ctx Context) func(int, genieql.ColumnMap, func(string) ast.Node) ([]
 	}
 }
 
+// placeholder destination used when decoding columns with an overridden type.
+const overridePlaceholder = "genieqloverride"
+
+// decodeOverride decodes into a placeholder of the native type and then converts
+// the assigned values to the override type. returns nil when the decoder does not
+// directly assign to the destination.
+func decodeOverride(ctx Context, column genieql.ColumnMap, from ast.Expr, to ast.Expr, errHandler func(string) ast.Node) (gen *ast.FuncLit, err error) {
+	type stmtCtx struct {
+		From   ast.Expr
+		To     ast.Expr
+		Type   ast.Expr
+		Column genieql.ColumnMap
+	}
+
+	var (
+		remaining   bool
+		placeholder = astutil.SelExpr(overridePlaceholder, "value")
+		typex       = astutil.MustParseExpr(ctx.FileSet, column.Definition.Native)
+		override    = astutil.MustParseExpr(ctx.FileSet, column.Definition.Override)
+	)
+
+	column.Definition.Nullable = false
+	if gen, err = genFunctionLiteral(ctx, column.Definition.Decode, stmtCtx{Type: astutil.UnwrapExpr(typex), From: from, To: placeholder, Column: column}, errHandler); err != nil {
+		return nil, err
+	}
+
+	ast.Inspect(gen.Body, func(n ast.Node) bool {
+		if assign, ok := n.(*ast.AssignStmt); ok {
+			for idx, lhs := range assign.Lhs {
+				if types.ExprString(astutil.UnwrapExpr(lhs)) != types.ExprString(placeholder) {
+					continue
+				}
+
+				assign.Lhs[idx] = to
+				assign.Rhs[idx] = conversion(override, assign.Rhs[idx])
+			}
+		}
+
+		return true
+	})
+
+	ast.Inspect(gen.Body, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && ident.Name == overridePlaceholder {
+			remaining = true
+		}
+
+		return !remaining
+	})
+
+	if remaining {
+		return nil, nil
+	}
+
+	return gen, nil
+}
+
+// conversion of the expression to the provided type.
+func conversion(typ ast.Expr, x ast.Expr) ast.Expr {
+	switch typ.(type) {
+	case *ast.Ident, *ast.SelectorExpr, *ast.ArrayType, *ast.MapType:
+	default:
+		typ = &ast.ParenExpr{X: typ}
+	}
+
+	return astutil.CallExpr(typ, x)
+}
+
 func fallbackDefinition(s string) genieql.ColumnDefinition {
 	return genieql.ColumnDefinition{
 		Type:       s,
@@ -160,8 +240,9 @@ func ColumnMapEncoder(ctx Context) func(int, genieql.ColumnMap, func(string) ast
 		}
 
 		var (
-			local = column.Local(i)
-			gen   *ast.FuncLit
+			local    = column.Local(i)
+			gen      *ast.FuncLit
+			override = column.Definition.Override
 		)
 
 		if column.Definition.Encode == "" {
@@ -188,6 +269,11 @@ func ColumnMapEncoder(ctx Context) func(int, genieql.ColumnMap, func(string) ast
 			from = &ast.StarExpr{X: from}
 		}
 
+		// convert the override type back into the native type before encoding.
+		if override != "" {
+			from = conversion(astutil.UnwrapExpr(typex), from)
+		}
+
 		if gen, err = genFunctionLiteral(ctx, column.Definition.Encode, stmtCtx{Type: astutil.UnwrapExpr(typex), From: from, To: local, Column: column}, errHandler); err != nil {
 			return nil, err
 		}
@@ -18,6 +18,7 @@ import (
 //go:generate genieql bootstrap --queryer=sqlx.Queryer --driver=github.com/jackc/pgx postgres://$USER@localhost:5432/genieql_test_template?sslmode=disable
 //go:generate genieql bootstrap --queryer=sqlx.Queryer --driver=github.com/jackc/pgx --output-file=generators-test.config postgres://$USER@localhost:5432/genieql_test_template?sslmode=disable
 //go:generate genieql bootstrap --queryer=sqlx.Queryer --driver=github.com/duckdb/duckdb-go --output-file=duckdb.test.config duckdb://localhost/duckdb.test.db
+//go:generate genieql bootstrap --queryer=sqlx.Queryer --output-file=sqlite3.test.config sqlite://localhost/sqlite3.test.db
 
 // Build Tag constants
 const (
@@ -37,6 +37,9 @@ func DialectConfig1(options ...genieql.ConfigurationOption) genieql.Configuratio
 		Quote:             "\"",
 		CValueTransformer: columninfo.NewNameTransformer(),
 		QueryInsert:       "INSERT INTO :gql.insert.tablename: (:gql.insert.columns:) VALUES :gql.insert.values::gql.insert.conflict: RETURNING :gql.insert.returning:",
+		QuerySelect:       "SELECT :gql.select.columns: FROM :gql.select.tablename: WHERE :gql.select.predicates:",
+		QueryUpdate:       "UPDATE :gql.update.tablename: SET :gql.update.columns: WHERE :gql.update.predicates: RETURNING :gql.update.returning:",
+		QueryDelete:       "DELETE FROM :gql.delete.tablename: WHERE :gql.delete.predicates: RETURNING :gql.delete.returning:",
 	}))
 	if err != nil {
 		log.Println("failed to register test dialect", dialect, err)
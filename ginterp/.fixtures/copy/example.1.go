@@ -0,0 +1,52 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// CopyExample1Explode generated by genieql
+func CopyExample1Explode(v *StructA) ([]interface{}, error) {
+	var (
+		c0 sql.NullInt64 // b
+		c1 sql.NullInt64 // c
+		c2 sql.NullBool  // d
+		c3 sql.NullBool  // e
+		c4 sql.NullBool  // f
+		c5 sql.NullInt64 // g
+		c6 sql.NullBool  // h
+	)
+
+	c0.Valid = true
+	c0.Int64 = int64(v.B)
+
+	c1.Valid = true
+	c1.Int64 = int64(v.C)
+
+	c2.Valid = true
+	c2.Bool = v.D
+
+	c3.Valid = true
+	c3.Bool = v.E
+
+	c4.Valid = true
+	c4.Bool = v.F
+
+	c5.Valid = true
+	c5.Int64 = int64(*v.G)
+
+	c6.Valid = true
+	c6.Bool = *v.H
+
+	return []interface{}{c0, c1, c2, c3, c4, c5, c6}, nil
+}
+
+// CopyExample1 generated by genieql
+// Basic Copy Example
+func CopyExample1(ctx context.Context, q *pgx.Conn, rows []StructA) (int64, error) {
+	return q.CopyFrom(ctx, pgx.Identifier{"struct_a"}, []string{"b", "c", "d", "e", "f", "g", "h"}, pgx.CopyFromSlice(len(rows), func(i int) ([]any, error) {
+		return CopyExample1Explode(&rows[i])
+	}))
+}
@@ -0,0 +1,64 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+	"iter"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// CopyExample2Explode generated by genieql
+func CopyExample2Explode(v *StructA) ([]interface{}, error) {
+	var (
+		c0 sql.NullInt64 // a
+		c1 sql.NullInt64 // b
+		c2 sql.NullInt64 // c
+		c3 sql.NullBool  // d
+		c4 sql.NullBool  // e
+		c5 sql.NullBool  // f
+		c6 sql.NullInt64 // g
+		c7 sql.NullBool  // h
+	)
+
+	c0.Valid = true
+	c0.Int64 = int64(v.A)
+
+	c1.Valid = true
+	c1.Int64 = int64(v.B)
+
+	c2.Valid = true
+	c2.Int64 = int64(v.C)
+
+	c3.Valid = true
+	c3.Bool = v.D
+
+	c4.Valid = true
+	c4.Bool = v.E
+
+	c5.Valid = true
+	c5.Bool = v.F
+
+	c6.Valid = true
+	c6.Int64 = int64(*v.G)
+
+	c7.Valid = true
+	c7.Bool = *v.H
+
+	return []interface{}{c0, c1, c2, c3, c4, c5, c6, c7}, nil
+}
+
+// CopyExample2 generated by genieql
+func CopyExample2(conn *pgx.Conn, _genieql_v iter.Seq[StructA]) (int64, error) {
+	next, stop := iter.Pull(_genieql_v)
+	defer stop()
+
+	return conn.CopyFrom(context.Background(), pgx.Identifier{"public", "struct_a"}, []string{"a", "b", "c", "d", "e", "f", "g", "h"}, pgx.CopyFromFunc(func() ([]any, error) {
+		v, ok := next()
+		if !ok {
+			return nil, nil
+		}
+
+		return CopyExample2Explode(&v)
+	}))
+}
@@ -0,0 +1,79 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// DeleteBatchExample1 generated by genieql
+func NewDeleteBatchExample1(ctx context.Context, q sqlx.Queryer, a ...StructA) ExampleScanner {
+	return &deleteBatchExample1{ctx: ctx, q: q, remaining: a}
+}
+
+type deleteBatchExample1 struct {
+	ctx       context.Context
+	q         sqlx.Queryer
+	remaining []StructA
+	scanner   ExampleScanner
+}
+
+func (t *deleteBatchExample1) Scan(a *StructA) error {
+	return t.scanner.Scan(a)
+}
+
+func (t *deleteBatchExample1) Err() error {
+	if t.scanner == nil {
+		return nil
+	}
+	return t.scanner.Err()
+}
+
+func (t *deleteBatchExample1) Close() error {
+	if t.scanner == nil {
+		return nil
+	}
+	return t.scanner.Close()
+}
+
+func (t *deleteBatchExample1) Next() bool {
+	var advanced bool
+	if t.scanner != nil && t.scanner.Next() {
+		return true
+	}
+	if len(t.remaining) > 0 && t.Close() == nil {
+		t.scanner, t.remaining, advanced = t.advance(t.remaining...)
+		return advanced && t.scanner.Next()
+	}
+	return false
+}
+
+func (t *deleteBatchExample1) advance(a ...StructA) (ExampleScanner, []StructA, bool) {
+	transform := func(a StructA) (c0 sql.NullInt64, err error) {
+		c0.Valid = true
+		c0.Int64 = int64(a.A)
+		return c0, nil
+	}
+	if len(a) == 0 {
+		return nil, []StructA(nil), false
+	}
+	n := len(a)
+	const queryPrefix = `WITH v(a) AS (VALUES `
+	const querySuffix = `) DELETE FROM foo AS t USING v WHERE t.a = v.a RETURNING t.a,t.b,t.c,t.d,t.e,t.f,t.g,t.h`
+	const valueTuple = `($%d)`
+	valueTuples := make([]string, 0, n)
+	args := make([]any, 0, n*1)
+	for i := range n {
+		valueTuples = append(valueTuples, fmt.Sprintf(valueTuple, i*1+1))
+		c0, err := transform(a[i])
+		if err != nil {
+			return NewExampleScannerStatic(nil, err), []StructA(nil), false
+		}
+		args = append(args, c0)
+	}
+	query := queryPrefix + strings.Join(valueTuples, `,`) + querySuffix
+	return NewExampleScannerStatic(t.q.QueryContext(t.ctx, query, args...)), a[n:], true
+}
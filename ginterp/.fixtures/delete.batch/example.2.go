@@ -0,0 +1,76 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// DeleteBatchExample2 generated by genieql
+func NewDeleteBatchExample2(ctx context.Context, q sqlx.Queryer, a ...StructA) ExampleScanner {
+	return &deleteBatchExample2{ctx: ctx, q: q, remaining: a}
+}
+
+type deleteBatchExample2 struct {
+	ctx       context.Context
+	q         sqlx.Queryer
+	remaining []StructA
+	scanner   ExampleScanner
+}
+
+func (t *deleteBatchExample2) Scan(a *StructA) error {
+	return t.scanner.Scan(a)
+}
+
+func (t *deleteBatchExample2) Err() error {
+	if t.scanner == nil {
+		return nil
+	}
+	return t.scanner.Err()
+}
+
+func (t *deleteBatchExample2) Close() error {
+	if t.scanner == nil {
+		return nil
+	}
+	return t.scanner.Close()
+}
+
+func (t *deleteBatchExample2) Next() bool {
+	var advanced bool
+	if t.scanner != nil && t.scanner.Next() {
+		return true
+	}
+	if len(t.remaining) > 0 && t.Close() == nil {
+		t.scanner, t.remaining, advanced = t.advance(t.remaining...)
+		return advanced && t.scanner.Next()
+	}
+	return false
+}
+
+func (t *deleteBatchExample2) advance(a ...StructA) (ExampleScanner, []StructA, bool) {
+	transform := func(a StructA) (c0 sql.NullInt64, c1 sql.NullInt64, err error) {
+		c0.Valid = true
+		c0.Int64 = int64(a.A)
+		c1.Valid = true
+		c1.Int64 = int64(a.C)
+		return c0, c1, nil
+	}
+	if len(a) == 0 {
+		return nil, []StructA(nil), false
+	}
+	n := len(a)
+	const query = `DELETE FROM foo AS t USING unnest($1::int8[], $2::int8[]) AS v(a,c) WHERE t.a = v.a AND t.c = v.c RETURNING t.a,t.b,t.c,t.d,t.e,t.f,t.g,t.h`
+	v0 := make([]sql.NullInt64, 0, n)
+	v1 := make([]sql.NullInt64, 0, n)
+	for i := range n {
+		c0, c1, err := transform(a[i])
+		if err != nil {
+			return NewExampleScannerStatic(nil, err), []StructA(nil), false
+		}
+		v0 = append(v0, c0)
+		v1 = append(v1, c1)
+	}
+	return NewExampleScannerStatic(t.q.QueryContext(t.ctx, query, v0, v1)), a[n:], true
+}
@@ -0,0 +1,18 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// DeleteExample1 generated by genieql
+// Basic Delete Example
+func DeleteExample1(ctx context.Context, q sqlx.Queryer, a int) ExampleRowScanner {
+	const query = `DELETE FROM struct_a WHERE a = $1 RETURNING a,b,c,d,e,f`
+	var c0 sql.NullInt64
+	c0.Valid = true
+	c0.Int64 = int64(a) // a
+	return NewExampleScannerStaticRow(q.QueryRowContext(ctx, query, c0))
+}
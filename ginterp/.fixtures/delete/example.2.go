@@ -0,0 +1,22 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// DeleteExample2 generated by genieql
+func DeleteExample2(ctx context.Context, q sqlx.Queryer, a StructA) ExampleRowScanner {
+	const query = `DELETE FROM struct_a WHERE a = $1 AND b = $2 RETURNING a,b,c,d,e,f`
+	var (
+		c0 sql.NullInt64 // a
+		c1 sql.NullInt64 // b
+	)
+	c0.Valid = true
+	c0.Int64 = int64(a.A)
+	c1.Valid = true
+	c1.Int64 = int64(a.B)
+	return NewExampleScannerStaticRow(q.QueryRowContext(ctx, query, c0, c1))
+}
@@ -0,0 +1,22 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// DeleteExample3 generated by genieql
+func DeleteExample3(ctx context.Context, q sqlx.Queryer, a StructA) ExampleRowScanner {
+	const query = `DELETE FROM struct_a WHERE b = $1 AND a = $2 RETURNING a,b,c,d,e,f`
+	var (
+		c0 sql.NullInt64 // b
+		c1 sql.NullInt64 // a
+	)
+	c0.Valid = true
+	c0.Int64 = int64(a.B)
+	c1.Valid = true
+	c1.Int64 = int64(a.A)
+	return NewExampleScannerStaticRow(q.QueryRowContext(ctx, query, c0, c1))
+}
@@ -0,0 +1,34 @@
+package example
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Mood type generated by genieql
+type Mood string
+
+const (
+	MoodHappy   Mood = "happy"
+	MoodSad     Mood = "sad"
+	MoodNotSure Mood = "not_sure"
+)
+
+// Scan implements sql.Scanner.
+func (t *Mood) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		*t = Mood(v)
+	case []byte:
+		*t = Mood(v)
+	default:
+		return fmt.Errorf("unable to scan %T into Mood", src)
+	}
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (t Mood) Value() (driver.Value, error) {
+	return string(t), nil
+}
@@ -24,3 +24,16 @@ type StructB struct {
 	A, B, C int
 	D, E, F bool
 }
+
+type Status string
+
+type StructC struct {
+	A      int
+	Status Status
+}
+
+// StructD total is computed by the database.
+type StructD struct {
+	A     int
+	Total int
+}
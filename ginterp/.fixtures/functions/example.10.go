@@ -0,0 +1,29 @@
+package example
+
+import (
+	"context"
+
+	"github.com/jackc/pgtype"
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// FunctionExample10 generated by genieql
+func FunctionExample10(ctx context.Context, q sqlx.Queryer, ids []int, limit int) ExampleScanner {
+	const query = `-- find the rows by their identifiers.
+SELECT a
+FROM struct_a
+WHERE a = ANY(?)
+LIMIT ?
+`
+	var (
+		c0 pgtype.Int8Array // ids
+		c1 pgtype.Int8      // limit
+	)
+	if err := c0.Set(ids); err != nil {
+		return StaticExampleScanner(nil, err)
+	}
+	if err := c1.Set(limit); err != nil {
+		return StaticExampleScanner(nil, err)
+	}
+	return StaticExampleScanner(q.QueryContext(ctx, query, c0, c1))
+}
@@ -0,0 +1,22 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// FunctionExample14 generated by genieql
+func FunctionExample14(ctx context.Context, q sqlx.Queryer, b int, limit int) ExampleScanner {
+	const query = `SELECT a FROM struct_a WHERE b = ? LIMIT ?`
+	var (
+		c0 sql.NullInt64 // b
+		c1 sql.NullInt64 // limit
+	)
+	c0.Valid = true
+	c0.Int64 = int64(b)
+	c1.Valid = true
+	c1.Int64 = int64(limit)
+	return StaticExampleScanner(q.QueryContext(ctx, query, c0, c1))
+}
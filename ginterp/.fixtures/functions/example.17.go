@@ -0,0 +1,36 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// FunctionExample17 generated by genieql
+func FunctionExample17(q sqlx.Queryer, flags []bool) (int64, error) {
+	var (
+		query string
+		args  []any
+	)
+	query = `DELETE FROM struct_a WHERE b IN (`
+	if len(flags) == 0 {
+		query += `NULL`
+	}
+	for idx, v := range flags {
+		if idx > 0 {
+			query += `,`
+		}
+		var c0 sql.NullBool
+		c0.Valid = true
+		c0.Bool = v
+		args = append(args, c0)
+		query += `?`
+	}
+	query += `)`
+	result, err := q.ExecContext(context.Background(), query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,50 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// FunctionExample18 generated by genieql
+func FunctionExample18(ctx context.Context, q sqlx.Queryer, genieqlV *bool, genieqlArgs []int, genieqlIdx int) ExampleScanner {
+	var (
+		c0 sql.NullBool  // genieqlV
+		c1 sql.NullInt64 // genieqlIdx
+	)
+	if genieqlV != nil {
+		c0.Valid = true
+		c0.Bool = *genieqlV
+	}
+	c1.Valid = true
+	c1.Int64 = int64(genieqlIdx)
+	var (
+		query string
+		args  []any
+	)
+	query = `SELECT a FROM struct_a WHERE a IN (`
+	if len(genieqlArgs) == 0 {
+		query += `NULL`
+	}
+	for idx, v := range genieqlArgs {
+		if idx > 0 {
+			query += `,`
+		}
+		var c3 sql.NullInt64
+		c3.Valid = true
+		c3.Int64 = int64(v)
+		args = append(args, c3)
+		query += `?`
+	}
+	query += `) `
+	if genieqlV != nil {
+		query += ` AND b = `
+		args = append(args, c0)
+		query += `?`
+	}
+	query += ` LIMIT `
+	args = append(args, c1)
+	query += `?`
+	return StaticExampleScanner(q.QueryContext(ctx, query, args...))
+}
@@ -0,0 +1,37 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// FunctionExample2 generated by genieql
+func FunctionExample2(ctx context.Context, q sqlx.Queryer, ids []int, limit int) ExampleScanner {
+	var c0 sql.NullInt64 // limit
+	c0.Valid = true
+	c0.Int64 = int64(limit)
+	var (
+		query string
+		args  []any
+	)
+	query = `SELECT a FROM struct_a WHERE a IN (`
+	if len(ids) == 0 {
+		query += `NULL`
+	}
+	for idx, v := range ids {
+		if idx > 0 {
+			query += `,`
+		}
+		var c1 sql.NullInt64
+		c1.Valid = true
+		c1.Int64 = int64(v)
+		args = append(args, c1)
+		query += `?`
+	}
+	query += `) LIMIT `
+	args = append(args, c0)
+	query += `?`
+	return StaticExampleScanner(q.QueryContext(ctx, query, args...))
+}
@@ -0,0 +1,24 @@
+package example
+
+import (
+	"context"
+
+	"github.com/jackc/pgtype"
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// FunctionExample3 generated by genieql
+func FunctionExample3(ctx context.Context, q sqlx.Queryer, ids []int, limit int) ExampleScanner {
+	const query = `SELECT a FROM struct_a WHERE a = ANY(?) LIMIT ?`
+	var (
+		c0 pgtype.Int8Array // ids
+		c1 pgtype.Int8      // limit
+	)
+	if err := c0.Set(ids); err != nil {
+		return StaticExampleScanner(nil, err)
+	}
+	if err := c1.Set(limit); err != nil {
+		return StaticExampleScanner(nil, err)
+	}
+	return StaticExampleScanner(q.QueryContext(ctx, query, c0, c1))
+}
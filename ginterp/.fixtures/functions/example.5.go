@@ -0,0 +1,51 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// FunctionExample5 generated by genieql
+func FunctionExample5(ctx context.Context, q sqlx.Queryer, b *bool, ids []int, limit int) ExampleScanner {
+	var (
+		c0 sql.NullBool  // b
+		c1 sql.NullInt64 // limit
+	)
+	if b != nil {
+		c0.Valid = true
+		c0.Bool = *b
+	}
+	c1.Valid = true
+	c1.Int64 = int64(limit)
+	var (
+		query string
+		args  []any
+	)
+	query = `SELECT a FROM struct_a WHERE 't' `
+	if b != nil {
+		query += ` AND b = `
+		args = append(args, c0)
+		query += `?`
+	}
+	query += ` `
+	if len(ids) > 0 {
+		query += ` AND a IN (`
+		for idx, v := range ids {
+			if idx > 0 {
+				query += `,`
+			}
+			var c3 sql.NullInt64
+			c3.Valid = true
+			c3.Int64 = int64(v)
+			args = append(args, c3)
+			query += `?`
+		}
+		query += `)`
+	}
+	query += ` LIMIT `
+	args = append(args, c1)
+	query += `?`
+	return StaticExampleScanner(q.QueryContext(ctx, query, args...))
+}
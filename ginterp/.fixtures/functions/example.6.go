@@ -0,0 +1,49 @@
+package example
+
+import (
+	"context"
+
+	"github.com/jackc/pgtype"
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// FunctionExample6 generated by genieql
+func FunctionExample6(ctx context.Context, q sqlx.Queryer, b *bool, ids []int, limit int) ExampleScanner {
+	var (
+		c0 pgtype.Bool      // b
+		c1 pgtype.Int8Array // ids
+		c2 pgtype.Int8      // limit
+	)
+	if b != nil {
+		if err := c0.Set(b); err != nil {
+			return StaticExampleScanner(nil, err)
+		}
+	}
+	if err := c1.Set(ids); err != nil {
+		return StaticExampleScanner(nil, err)
+	}
+	if err := c2.Set(limit); err != nil {
+		return StaticExampleScanner(nil, err)
+	}
+	var (
+		query string
+		args  []any
+	)
+	query = `SELECT a FROM struct_a WHERE 't' `
+	if b != nil {
+		query += ` AND b = `
+		args = append(args, c0)
+		query += `?`
+	}
+	query += ` `
+	if len(ids) > 0 {
+		query += ` AND a = ANY(`
+		args = append(args, c1)
+		query += `?`
+		query += `)`
+	}
+	query += ` LIMIT `
+	args = append(args, c2)
+	query += `?`
+	return StaticExampleScanner(q.QueryContext(ctx, query, args...))
+}
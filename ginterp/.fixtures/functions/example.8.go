@@ -0,0 +1,19 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jackc/pgtype"
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// FunctionExample8 generated by genieql
+func FunctionExample8(ctx context.Context, q sqlx.Queryer, ids []int) (sql.Result, error) {
+	const query = `DELETE FROM struct_a WHERE a = ANY(?)`
+	var c0 pgtype.Int8Array // ids
+	if err := c0.Set(ids); err != nil {
+		return nil, err
+	}
+	return q.ExecContext(ctx, query, c0)
+}
@@ -0,0 +1,26 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// FunctionExample9 generated by genieql
+func FunctionExample9(q sqlx.Queryer, b *bool, limit int) (int64, error) {
+	const query = `UPDATE struct_a SET b = ? WHERE a < ?`
+	var (
+		c0 sql.NullBool  // b
+		c1 sql.NullInt64 // limit
+	)
+	c0.Valid = true
+	c0.Bool = *b
+	c1.Valid = true
+	c1.Int64 = int64(limit)
+	result, err := q.ExecContext(context.Background(), query, c0, c1)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,91 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// BatchInsertExample1 generated by genieql
+func NewBatchInsertExample1(ctx context.Context, q sqlx.Queryer, a ...StructA) ExampleScanner {
+	return &batchInsertExample1{ctx: ctx, q: q, remaining: a}
+}
+
+type batchInsertExample1 struct {
+	ctx       context.Context
+	q         sqlx.Queryer
+	remaining []StructA
+	scanner   ExampleScanner
+}
+
+func (t *batchInsertExample1) Scan(a *StructA) error {
+	return t.scanner.Scan(a)
+}
+
+func (t *batchInsertExample1) Err() error {
+	if t.scanner == nil {
+		return nil
+	}
+	return t.scanner.Err()
+}
+
+func (t *batchInsertExample1) Close() error {
+	if t.scanner == nil {
+		return nil
+	}
+	return t.scanner.Close()
+}
+
+func (t *batchInsertExample1) Next() bool {
+	var advanced bool
+	if t.scanner != nil && t.scanner.Next() {
+		return true
+	}
+	if len(t.remaining) > 0 && t.Close() == nil {
+		t.scanner, t.remaining, advanced = t.advance(t.remaining...)
+		return advanced && t.scanner.Next()
+	}
+	return false
+}
+
+func (t *batchInsertExample1) advance(a ...StructA) (ExampleScanner, []StructA, bool) {
+	transform := func(a StructA) (c0 sql.NullInt64, c1 sql.NullInt64, c2 sql.NullBool, c3 sql.NullBool, c4 sql.NullBool, c5 sql.NullInt64, c6 sql.NullBool, err error) {
+		c0.Valid = true
+		c0.Int64 = int64(a.A)
+		c1.Valid = true
+		c1.Int64 = int64(a.C)
+		c2.Valid = true
+		c2.Bool = a.D
+		c3.Valid = true
+		c3.Bool = a.E
+		c4.Valid = true
+		c4.Bool = a.F
+		c5.Valid = true
+		c5.Int64 = int64(*a.G)
+		c6.Valid = true
+		c6.Bool = *a.H
+		return c0, c1, c2, c3, c4, c5, c6, nil
+	}
+	if len(a) == 0 {
+		return nil, []StructA(nil), false
+	}
+	n := min(len(a), 14)
+	const queryPrefix = `INSERT INTO foo (a,b,c,d,e,f,g,h) VALUES `
+	const querySuffix = ` RETURNING a,b,c,d,e,f,g,h`
+	const valueTuple = `($%d,DEFAULT,$%d,$%d,$%d,$%d,$%d,$%d)`
+	valueTuples := make([]string, 0, n)
+	args := make([]any, 0, n*7)
+	for i := range n {
+		valueTuples = append(valueTuples, fmt.Sprintf(valueTuple, i*7+1, i*7+2, i*7+3, i*7+4, i*7+5, i*7+6, i*7+7))
+		c0, c1, c2, c3, c4, c5, c6, err := transform(a[i])
+		if err != nil {
+			return NewExampleScannerStatic(nil, err), []StructA(nil), false
+		}
+		args = append(args, c0, c1, c2, c3, c4, c5, c6)
+	}
+	query := queryPrefix + strings.Join(valueTuples, `,`) + querySuffix
+	return NewExampleScannerStatic(t.q.QueryContext(t.ctx, query, args...)), a[n:], true
+}
@@ -0,0 +1,108 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// BatchInsertExample1 generated by genieql
+func NewBatchInsertExample1(ctx context.Context, q *sql.DB, a ...StructA) ExampleScanner {
+	return &batchInsertExample1{ctx: ctx, q: q, remaining: a}
+}
+
+type batchInsertExample1 struct {
+	ctx       context.Context
+	q         *sql.DB
+	remaining []StructA
+	scanner   ExampleScanner
+	tx        *sql.Tx
+}
+
+func (t *batchInsertExample1) Scan(a *StructA) error {
+	return t.scanner.Scan(a)
+}
+
+func (t *batchInsertExample1) Err() error {
+	if t.scanner == nil {
+		return nil
+	}
+	return t.scanner.Err()
+}
+
+func (t *batchInsertExample1) Close() error {
+	var err error
+	if t.scanner != nil {
+		err = errors.Join(t.scanner.Err(), t.scanner.Close())
+	}
+	if t.tx == nil {
+		return err
+	}
+	tx := t.tx
+	t.tx = nil
+	if err != nil || len(t.remaining) > 0 {
+		return errors.Join(err, tx.Rollback())
+	}
+	return tx.Commit()
+}
+
+func (t *batchInsertExample1) Next() bool {
+	var advanced bool
+	if t.scanner != nil && t.scanner.Next() {
+		return true
+	}
+	if len(t.remaining) > 0 && (t.scanner == nil || t.scanner.Close() == nil) {
+		t.scanner, t.remaining, advanced = t.advance(t.remaining...)
+		return advanced && t.scanner.Next()
+	}
+	return false
+}
+
+func (t *batchInsertExample1) advance(a ...StructA) (ExampleScanner, []StructA, bool) {
+	transform := func(a StructA) (c0 sql.NullInt64, c1 sql.NullInt64, c2 sql.NullInt64, c3 sql.NullBool, c4 sql.NullBool, c5 sql.NullBool, c6 sql.NullInt64, c7 sql.NullBool, err error) {
+		c0.Valid = true
+		c0.Int64 = int64(a.A)
+		c1.Valid = true
+		c1.Int64 = int64(a.B)
+		c2.Valid = true
+		c2.Int64 = int64(a.C)
+		c3.Valid = true
+		c3.Bool = a.D
+		c4.Valid = true
+		c4.Bool = a.E
+		c5.Valid = true
+		c5.Bool = a.F
+		c6.Valid = true
+		c6.Int64 = int64(*a.G)
+		c7.Valid = true
+		c7.Bool = *a.H
+		return c0, c1, c2, c3, c4, c5, c6, c7, nil
+	}
+	if len(a) == 0 {
+		return nil, []StructA(nil), false
+	}
+	n := len(a)
+	const queryPrefix = `INSERT INTO foo (a,b,c,d,e,f,g,h) VALUES `
+	const querySuffix = ` RETURNING a,b,c,d,e,f,g,h`
+	const valueTuple = `($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d)`
+	valueTuples := make([]string, 0, n)
+	args := make([]any, 0, n*8)
+	for i := range n {
+		valueTuples = append(valueTuples, fmt.Sprintf(valueTuple, i*8+1, i*8+2, i*8+3, i*8+4, i*8+5, i*8+6, i*8+7, i*8+8))
+		c0, c1, c2, c3, c4, c5, c6, c7, err := transform(a[i])
+		if err != nil {
+			return NewExampleScannerStatic(nil, err), []StructA(nil), false
+		}
+		args = append(args, c0, c1, c2, c3, c4, c5, c6, c7)
+	}
+	query := queryPrefix + strings.Join(valueTuples, `,`) + querySuffix
+	if t.tx == nil {
+		var err error
+		if t.tx, err = t.q.BeginTx(t.ctx, nil); err != nil {
+			return NewExampleScannerStatic(nil, err), []StructA(nil), false
+		}
+	}
+	return NewExampleScannerStatic(t.tx.QueryContext(t.ctx, query, args...)), a[n:], true
+}
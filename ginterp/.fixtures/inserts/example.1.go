@@ -62,7 +62,7 @@ func InsertExample1(ctx context.Context, q sqlx.Queryer, a StructA) ExampleScann
 		c4 sql.NullBool  // e
 		c5 sql.NullBool  // f
 		c6 sql.NullInt64 // g
-		c7 sql.NullBool
+		c7 sql.NullBool  // h
 	)
 	c0.Valid = true
 	c0.Int64 = int64(a.A)
@@ -79,6 +79,6 @@ func InsertExample1(ctx context.Context, q sqlx.Queryer, a StructA) ExampleScann
 	c6.Valid = true
 	c6.Int64 = int64(*a.G)
 	c7.Valid = true
-	c7.Bool = *a.H // h
+	c7.Bool = *a.H
 	return NewExampleScannerStatic(q.QueryContext(ctx, query, c0, c1, c2, c3, c4, c5, c6, c7))
 }
@@ -0,0 +1,85 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// InsertExample10StaticColumns generated by genieql
+const InsertExample10StaticColumns = `DEFAULT,?,?,?,?,?,?,?`
+
+// InsertExample10Explode generated by genieql
+func InsertExample10Explode(genieqlResult *StructA) ([]interface{}, error) {
+	var (
+		c0 sql.NullInt64 // b
+		c1 sql.NullInt64 // c
+		c2 sql.NullBool  // d
+		c3 sql.NullBool  // e
+		c4 sql.NullBool  // f
+		c5 sql.NullInt64 // g
+		c6 sql.NullBool  // h
+	)
+
+	c0.Valid = true
+	c0.Int64 = int64(genieqlResult.B)
+
+	c1.Valid = true
+	c1.Int64 = int64(genieqlResult.C)
+
+	c2.Valid = true
+	c2.Bool = genieqlResult.D
+
+	c3.Valid = true
+	c3.Bool = genieqlResult.E
+
+	c4.Valid = true
+	c4.Bool = genieqlResult.F
+
+	c5.Valid = true
+	c5.Int64 = int64(*genieqlResult.G)
+
+	c6.Valid = true
+	c6.Bool = *genieqlResult.H
+
+	return []interface{}{c0, c1, c2, c3, c4, c5, c6}, nil
+}
+
+// InsertExample10 generated by genieql
+func InsertExample10(ctx context.Context, q sqlx.Queryer, genieqlResult StructA) ExampleScanner {
+	const insertquery = `INSERT INTO struct_a_pk (a,b,c,d,e,f,g,h) VALUES (DEFAULT,$1,$2,$3,$4,$5,$6,$7)`
+	const query = `SELECT a,b,c,d,e,f,g,h FROM struct_a_pk WHERE a = $1 AND b = $2`
+	var (
+		c0 sql.NullInt64 // b
+		c1 sql.NullInt64 // c
+		c2 sql.NullBool  // d
+		c3 sql.NullBool  // e
+		c4 sql.NullBool  // f
+		c5 sql.NullInt64 // g
+		c6 sql.NullBool  // h
+	)
+	c0.Valid = true
+	c0.Int64 = int64(genieqlResult.B)
+	c1.Valid = true
+	c1.Int64 = int64(genieqlResult.C)
+	c2.Valid = true
+	c2.Bool = genieqlResult.D
+	c3.Valid = true
+	c3.Bool = genieqlResult.E
+	c4.Valid = true
+	c4.Bool = genieqlResult.F
+	c5.Valid = true
+	c5.Int64 = int64(*genieqlResult.G)
+	c6.Valid = true
+	c6.Bool = *genieqlResult.H
+	result, err := q.ExecContext(ctx, insertquery, c0, c1, c2, c3, c4, c5, c6)
+	if err != nil {
+		return NewExampleScannerStatic(nil, err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return NewExampleScannerStatic(nil, err)
+	}
+	return NewExampleScannerStatic(q.QueryContext(ctx, query, id, c0))
+}
@@ -0,0 +1,87 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// InsertExample11StaticColumns generated by genieql
+const InsertExample11StaticColumns = `?,?,?,?,?,?,?,?`
+
+// InsertExample11Explode generated by genieql
+func InsertExample11Explode(a *StructA) ([]interface{}, error) {
+	var (
+		c0 sql.NullInt64 // a
+		c1 sql.NullInt64 // b
+		c2 sql.NullInt64 // c
+		c3 sql.NullBool  // d
+		c4 sql.NullBool  // e
+		c5 sql.NullBool  // f
+		c6 sql.NullInt64 // g
+		c7 sql.NullBool  // h
+	)
+
+	c0.Valid = true
+	c0.Int64 = int64(a.A)
+
+	c1.Valid = true
+	c1.Int64 = int64(a.B)
+
+	c2.Valid = true
+	c2.Int64 = int64(a.C)
+
+	c3.Valid = true
+	c3.Bool = a.D
+
+	c4.Valid = true
+	c4.Bool = a.E
+
+	c5.Valid = true
+	c5.Bool = a.F
+
+	c6.Valid = true
+	c6.Int64 = int64(*a.G)
+
+	c7.Valid = true
+	c7.Bool = *a.H
+
+	return []interface{}{c0, c1, c2, c3, c4, c5, c6, c7}, nil
+}
+
+// InsertExample11 generated by genieql
+func InsertExample11(ctx context.Context, q sqlx.Queryer, a StructA) ExampleScanner {
+	const insertquery = `INSERT INTO struct_a_pk (a,b,c,d,e,f,g,h) VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`
+	const query = `SELECT a,b,c,d,e,f,g,h FROM struct_a_pk WHERE a = $1 AND b = $2`
+	var (
+		c0 sql.NullInt64 // a
+		c1 sql.NullInt64 // b
+		c2 sql.NullInt64 // c
+		c3 sql.NullBool  // d
+		c4 sql.NullBool  // e
+		c5 sql.NullBool  // f
+		c6 sql.NullInt64 // g
+		c7 sql.NullBool  // h
+	)
+	c0.Valid = true
+	c0.Int64 = int64(a.A)
+	c1.Valid = true
+	c1.Int64 = int64(a.B)
+	c2.Valid = true
+	c2.Int64 = int64(a.C)
+	c3.Valid = true
+	c3.Bool = a.D
+	c4.Valid = true
+	c4.Bool = a.E
+	c5.Valid = true
+	c5.Bool = a.F
+	c6.Valid = true
+	c6.Int64 = int64(*a.G)
+	c7.Valid = true
+	c7.Bool = *a.H
+	if _, err := q.ExecContext(ctx, insertquery, c0, c1, c2, c3, c4, c5, c6, c7); err != nil {
+		return NewExampleScannerStatic(nil, err)
+	}
+	return NewExampleScannerStatic(q.QueryContext(ctx, query, c0, c1))
+}
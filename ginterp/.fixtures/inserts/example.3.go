@@ -56,7 +56,7 @@ func InsertExample3(ctx context.Context, q sqlx.Queryer, a StructA) ExampleScann
 		c3 sql.NullBool  // e
 		c4 sql.NullBool  // f
 		c5 sql.NullInt64 // g
-		c6 sql.NullBool
+		c6 sql.NullBool  // h
 	)
 	c0.Valid = true
 	c0.Int64 = int64(a.B)
@@ -71,6 +71,6 @@ func InsertExample3(ctx context.Context, q sqlx.Queryer, a StructA) ExampleScann
 	c5.Valid = true
 	c5.Int64 = int64(*a.G)
 	c6.Valid = true
-	c6.Bool = *a.H // h
+	c6.Bool = *a.H
 	return NewExampleScannerStatic(q.QueryContext(ctx, query, c0, c1, c2, c3, c4, c5, c6))
 }
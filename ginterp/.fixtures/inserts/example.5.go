@@ -51,7 +51,7 @@ func InsertExample5(ctx context.Context, q sqlx.Queryer, a StructA) ExampleScann
 		c2 sql.NullBool  // e
 		c3 sql.NullBool  // f
 		c4 sql.NullInt64 // g
-		c5 sql.NullBool
+		c5 sql.NullBool  // h
 	)
 	c0.Valid = true
 	c0.Int64 = int64(a.C)
@@ -64,6 +64,6 @@ func InsertExample5(ctx context.Context, q sqlx.Queryer, a StructA) ExampleScann
 	c4.Valid = true
 	c4.Int64 = int64(*a.G)
 	c5.Valid = true
-	c5.Bool = *a.H // h
+	c5.Bool = *a.H
 	return NewExampleScannerStatic(q.QueryContext(ctx, query, c0, c1, c2, c3, c4, c5))
 }
@@ -0,0 +1,76 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// InsertExample7StaticColumns generated by genieql
+const InsertExample7StaticColumns = `a,DEFAULT,c,d,e,f,g,h`
+
+// InsertExample7Explode generated by genieql
+func InsertExample7Explode(a *StructA) ([]interface{}, error) {
+	var (
+		c0 sql.NullInt64 // a
+		c1 sql.NullInt64 // c
+		c2 sql.NullBool  // d
+		c3 sql.NullBool  // e
+		c4 sql.NullBool  // f
+		c5 sql.NullInt64 // g
+		c6 sql.NullBool  // h
+	)
+
+	c0.Valid = true
+	c0.Int64 = int64(a.A)
+
+	c1.Valid = true
+	c1.Int64 = int64(a.C)
+
+	c2.Valid = true
+	c2.Bool = a.D
+
+	c3.Valid = true
+	c3.Bool = a.E
+
+	c4.Valid = true
+	c4.Bool = a.F
+
+	c5.Valid = true
+	c5.Int64 = int64(*a.G)
+
+	c6.Valid = true
+	c6.Bool = *a.H
+
+	return []interface{}{c0, c1, c2, c3, c4, c5, c6}, nil
+}
+
+// InsertExample7 generated by genieql
+func InsertExample7(ctx context.Context, q sqlx.Queryer, a StructA) ExampleScanner {
+	const query = `INSERT INTO struct_a (a,b,c,d,e,f,g,h) VALUES ($1,DEFAULT,$2,$3,$4,$5,$6,$7) ON CONFLICT (a) DO NOTHING RETURNING a,b,c,d,e,f,g,h`
+	var (
+		c0 sql.NullInt64 // a
+		c1 sql.NullInt64 // c
+		c2 sql.NullBool  // d
+		c3 sql.NullBool  // e
+		c4 sql.NullBool  // f
+		c5 sql.NullInt64 // g
+		c6 sql.NullBool  // h
+	)
+	c0.Valid = true
+	c0.Int64 = int64(a.A)
+	c1.Valid = true
+	c1.Int64 = int64(a.C)
+	c2.Valid = true
+	c2.Bool = a.D
+	c3.Valid = true
+	c3.Bool = a.E
+	c4.Valid = true
+	c4.Bool = a.F
+	c5.Valid = true
+	c5.Int64 = int64(*a.G)
+	c6.Valid = true
+	c6.Bool = *a.H
+	return NewExampleScannerStatic(q.QueryContext(ctx, query, c0, c1, c2, c3, c4, c5, c6))
+}
@@ -0,0 +1,32 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// InsertExample9StaticColumns generated by genieql
+const InsertExample9StaticColumns = `a,DEFAULT`
+
+// InsertExample9Explode generated by genieql
+func InsertExample9Explode(a *StructD) ([]interface{}, error) {
+	var (
+		c0 sql.NullInt64 // a
+	)
+
+	c0.Valid = true
+	c0.Int64 = int64(a.A)
+
+	return []interface{}{c0}, nil
+}
+
+// InsertExample9 generated by genieql
+func InsertExample9(ctx context.Context, q sqlx.Queryer, a StructD) ExampleScanner {
+	const query = `INSERT INTO struct_d (a,total) VALUES ($1,DEFAULT) RETURNING a,total`
+	var c0 sql.NullInt64
+	c0.Valid = true
+	c0.Int64 = int64(a.A) // a
+	return NewExampleScannerStatic(q.QueryContext(ctx, query, c0))
+}
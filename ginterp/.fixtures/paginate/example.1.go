@@ -0,0 +1,67 @@
+package example
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// PaginateExample1 generated by genieql
+// Basic Paginate Example
+func PaginateExample1(ctx context.Context, q sqlx.Queryer, after string, limit int) ([]StructA, string, error) {
+	const first = `SELECT a,b,c,d,e,f,g,h FROM struct_a ORDER BY a LIMIT $1`
+	const query = `SELECT a,b,c,d,e,f,g,h FROM struct_a WHERE (a) > ($1) ORDER BY a LIMIT $2`
+	var (
+		err     error
+		raw     []byte
+		results []StructA
+		scanner ExampleScanner
+		cursor  struct {
+			A int `json:"a"`
+		}
+	)
+
+	if after == "" {
+		scanner = NewExampleScannerStatic(q.QueryContext(ctx, first, limit))
+	} else {
+		if raw, err = base64.RawURLEncoding.DecodeString(after); err != nil {
+			return nil, "", err
+		}
+
+		if err = json.Unmarshal(raw, &cursor); err != nil {
+			return nil, "", err
+		}
+
+		scanner = NewExampleScannerStatic(q.QueryContext(ctx, query, cursor.A, limit))
+	}
+	defer scanner.Close()
+
+	for scanner.Next() {
+		var r StructA
+
+		if err = scanner.Scan(&r); err != nil {
+			return nil, "", err
+		}
+
+		results = append(results, r)
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(results) == 0 || len(results) < int(limit) {
+		return results, "", nil
+	}
+
+	last := results[len(results)-1]
+	cursor.A = last.A
+
+	if raw, err = json.Marshal(cursor); err != nil {
+		return nil, "", err
+	}
+
+	return results, base64.RawURLEncoding.EncodeToString(raw), nil
+}
@@ -0,0 +1,66 @@
+package example
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+)
+
+// PaginateExample2 generated by genieql
+func PaginateExample2(db *sql.DB, _genieql_cursor string, n uint64) ([]StructA, string, error) {
+	const first = `SELECT a,b,c,d,e,f,g,h FROM struct_a ORDER BY b,a LIMIT $1`
+	const query = `SELECT a,b,c,d,e,f,g,h FROM struct_a WHERE (b,a) > ($1,$2) ORDER BY b,a LIMIT $3`
+	var (
+		err     error
+		raw     []byte
+		results []StructA
+		scanner ExampleScanner
+		cursor  struct {
+			B int `json:"b"`
+			A int `json:"a"`
+		}
+	)
+
+	if _genieql_cursor == "" {
+		scanner = NewExampleScannerStatic(db.Query(first, n))
+	} else {
+		if raw, err = base64.RawURLEncoding.DecodeString(_genieql_cursor); err != nil {
+			return nil, "", err
+		}
+
+		if err = json.Unmarshal(raw, &cursor); err != nil {
+			return nil, "", err
+		}
+
+		scanner = NewExampleScannerStatic(db.Query(query, cursor.B, cursor.A, n))
+	}
+	defer scanner.Close()
+
+	for scanner.Next() {
+		var r StructA
+
+		if err = scanner.Scan(&r); err != nil {
+			return nil, "", err
+		}
+
+		results = append(results, r)
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(results) == 0 || len(results) < int(n) {
+		return results, "", nil
+	}
+
+	last := results[len(results)-1]
+	cursor.B = last.B
+	cursor.A = last.A
+
+	if raw, err = json.Marshal(cursor); err != nil {
+		return nil, "", err
+	}
+
+	return results, base64.RawURLEncoding.EncodeToString(raw), nil
+}
@@ -0,0 +1,112 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgtype"
+)
+
+// Prepared generated by genieql
+type Prepared struct {
+	functionExample3 *sql.Stmt
+	functionExample8 *sql.Stmt
+	updateExample1   *sql.Stmt
+	deleteExample1   *sql.Stmt
+}
+
+// Prepare the statements of Prepared. the statements are closed when preparation fails.
+func Prepare(ctx context.Context, db interface {
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+}) (_ *Prepared, err error) {
+	var p Prepared
+	if p.functionExample3, err = db.PrepareContext(ctx, `SELECT a FROM struct_a WHERE a = ANY(?) LIMIT ?`); err != nil {
+		return nil, errors.Join(err, p.Close())
+	}
+	if p.functionExample8, err = db.PrepareContext(ctx, `DELETE FROM struct_a WHERE a = ANY(?)`); err != nil {
+		return nil, errors.Join(err, p.Close())
+	}
+	if p.updateExample1, err = db.PrepareContext(ctx, `UPDATE foo SET b = $1, c = $2, d = $3, e = $4, f = $5, g = $6, h = $7 WHERE a = $8 RETURNING a,b,c,d,e,f,g,h`); err != nil {
+		return nil, errors.Join(err, p.Close())
+	}
+	if p.deleteExample1, err = db.PrepareContext(ctx, `DELETE FROM struct_a WHERE a = $1 RETURNING a,b,c,d,e,f`); err != nil {
+		return nil, errors.Join(err, p.Close())
+	}
+	return &p, nil
+}
+
+// Close the prepared statements.
+func (t *Prepared) Close() (err error) {
+	for _, stmt := range []*sql.Stmt{t.functionExample3, t.functionExample8, t.updateExample1, t.deleteExample1} {
+		if stmt != nil {
+			err = errors.Join(err, stmt.Close())
+		}
+	}
+	return err
+}
+
+// FunctionExample3 generated by genieql
+func (t *Prepared) FunctionExample3(ctx context.Context, ids []int, limit int) ExampleScanner {
+	var (
+		c0 pgtype.Int8Array
+		c1 pgtype.Int8
+	)
+	if err := c0.Set(ids); err != nil {
+		return StaticExampleScanner(nil, err)
+	}
+	if err := c1.Set(limit); err != nil {
+		return StaticExampleScanner(nil, err)
+	}
+	return StaticExampleScanner(t.functionExample3.QueryContext(ctx, c0, c1))
+}
+
+// FunctionExample8 generated by genieql
+func (t *Prepared) FunctionExample8(ctx context.Context, ids []int) (sql.Result, error) {
+	var c0 pgtype.Int8Array
+	if err := c0.Set(ids); err != nil {
+		return nil, err
+	}
+	return t.functionExample8.ExecContext(ctx, c0)
+}
+
+// UpdateExample1 generated by genieql
+// Basic Update Example
+func (t *Prepared) UpdateExample1(ctx context.Context, id int, a StructA) ExampleRowScanner {
+	var (
+		c0 sql.NullInt64
+		c1 sql.NullInt64
+		c2 sql.NullBool
+		c3 sql.NullBool
+		c4 sql.NullBool
+		c5 sql.NullInt64
+		c6 sql.NullBool
+		c7 sql.NullInt64
+	)
+	c0.Valid = true
+	c0.Int64 = int64(a.B)
+	c1.Valid = true
+	c1.Int64 = int64(a.C)
+	c2.Valid = true
+	c2.Bool = a.D
+	c3.Valid = true
+	c3.Bool = a.E
+	c4.Valid = true
+	c4.Bool = a.F
+	c5.Valid = true
+	c5.Int64 = int64(*a.G)
+	c6.Valid = true
+	c6.Bool = *a.H
+	c7.Valid = true
+	c7.Int64 = int64(id)
+	return NewExampleScannerStaticRow(t.updateExample1.QueryRowContext(ctx, c0, c1, c2, c3, c4, c5, c6, c7))
+}
+
+// DeleteExample1 generated by genieql
+// Basic Delete Example
+func (t *Prepared) DeleteExample1(ctx context.Context, a int) ExampleRowScanner {
+	var c0 sql.NullInt64
+	c0.Valid = true
+	c0.Int64 = int64(a)
+	return NewExampleScannerStaticRow(t.deleteExample1.QueryRowContext(ctx, c0))
+}
@@ -0,0 +1,50 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgtype"
+)
+
+// PreparedExample2 generated by genieql
+type PreparedExample2 struct {
+	functionExample3 *sql.Stmt
+}
+
+// PrepareExample2 the statements of PreparedExample2. the statements are closed when preparation fails.
+func PrepareExample2(ctx context.Context, db interface {
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+}) (_ *PreparedExample2, err error) {
+	var p PreparedExample2
+	if p.functionExample3, err = db.PrepareContext(ctx, `SELECT a FROM struct_a WHERE a = ANY(?) LIMIT ?`); err != nil {
+		return nil, errors.Join(err, p.Close())
+	}
+	return &p, nil
+}
+
+// Close the prepared statements.
+func (t *PreparedExample2) Close() (err error) {
+	for _, stmt := range []*sql.Stmt{t.functionExample3} {
+		if stmt != nil {
+			err = errors.Join(err, stmt.Close())
+		}
+	}
+	return err
+}
+
+// FunctionExample3 generated by genieql
+func (t *PreparedExample2) FunctionExample3(ctx context.Context, ids []int, limit int) ExampleScanner {
+	var (
+		c0 pgtype.Int8Array
+		c1 pgtype.Int8
+	)
+	if err := c0.Set(ids); err != nil {
+		return StaticExampleScanner(nil, err)
+	}
+	if err := c1.Set(limit); err != nil {
+		return StaticExampleScanner(nil, err)
+	}
+	return StaticExampleScanner(t.functionExample3.QueryContext(ctx, c0, c1))
+}
@@ -0,0 +1,5 @@
+-- find the rows by their identifiers.
+SELECT a
+FROM struct_a
+WHERE a IN ({ids...})
+LIMIT {limit}
@@ -0,0 +1,47 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// StructAFindByA generated by genieql
+func StructAFindByA(ctx context.Context, q sqlx.Queryer, c sql.NullInt64) ExampleRowScanner {
+	const query = `SELECT a,b,c,d FROM struct_a_pk WHERE a = $1`
+	return NewExampleScannerStaticRow(q.QueryRowContext(ctx, query, c))
+}
+
+// StructAFindByB generated by genieql
+func StructAFindByB(ctx context.Context, q sqlx.Queryer, c sql.NullInt64) ExampleRowScanner {
+	const query = `SELECT a,b,c,d FROM struct_a_pk WHERE b = $1`
+	return NewExampleScannerStaticRow(q.QueryRowContext(ctx, query, c))
+}
+
+// StructAFindByC generated by genieql
+func StructAFindByC(ctx context.Context, q sqlx.Queryer, c sql.NullInt64) ExampleRowScanner {
+	const query = `SELECT a,b,c,d FROM struct_a_pk WHERE c = $1`
+	return NewExampleScannerStaticRow(q.QueryRowContext(ctx, query, c))
+}
+
+// StructAFindByKey generated by genieql
+func StructAFindByKey(ctx context.Context, q sqlx.Queryer, aKey sql.NullInt64, b sql.NullInt64) ExampleRowScanner {
+	const query = `SELECT a,b,c,d FROM struct_a_pk WHERE a = $1 AND b = $2`
+	return NewExampleScannerStaticRow(q.QueryRowContext(ctx, query, aKey, b))
+}
+
+// StructAUpdateByID generated by genieql
+func StructAUpdateByID(ctx context.Context, q sqlx.Queryer, aKey sql.NullInt64, b sql.NullInt64, a StructA) ExampleRowScanner {
+	const query = `UPDATE struct_a_pk SET c = $1 WHERE a = $2 AND b = $3 RETURNING a,b,c,d`
+	var c0 sql.NullInt64
+	c0.Valid = true
+	c0.Int64 = int64(a.C) // c
+	return NewExampleScannerStaticRow(q.QueryRowContext(ctx, query, c0, aKey, b))
+}
+
+// StructADeleteByID generated by genieql
+func StructADeleteByID(ctx context.Context, q sqlx.Queryer, a sql.NullInt64, b sql.NullInt64) ExampleRowScanner {
+	const query = `DELETE FROM struct_a_pk WHERE a = $1 AND b = $2 RETURNING a,b,c,d`
+	return NewExampleScannerStaticRow(q.QueryRowContext(ctx, query, a, b))
+}
@@ -0,0 +1,14 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// StructALookupByA generated by genieql
+func StructALookupByA(ctx context.Context, q sqlx.Queryer, c sql.NullInt64) ExampleScanner {
+	const query = `SELECT a,b,c,d FROM struct_a_pk WHERE a = $1`
+	return NewExampleScannerStatic(q.QueryContext(ctx, query, c))
+}
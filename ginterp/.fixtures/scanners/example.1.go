@@ -0,0 +1,232 @@
+package example
+
+import "database/sql"
+
+// ScannerExample1 scanner interface.
+type ScannerExample1 interface {
+	Scan(c *StructC) error
+	Next() bool
+	Close() error
+	Err() error
+}
+
+type errScannerExample1 struct {
+	e error
+}
+
+func (t errScannerExample1) Scan(c *StructC) error {
+	return t.e
+}
+
+func (t errScannerExample1) Next() bool {
+	return false
+}
+
+func (t errScannerExample1) Err() error {
+	return t.e
+}
+
+func (t errScannerExample1) Close() error {
+	return nil
+}
+
+// ScannerExample1StaticColumns generated by genieql
+const ScannerExample1StaticColumns = `"a","status"`
+
+// NewScannerExample1Static creates a scanner that operates on a static
+// set of columns that are always returned in the same order.
+func NewScannerExample1Static(rows *sql.Rows, err error) ScannerExample1 {
+	if err != nil {
+		return errScannerExample1{e: err}
+	}
+
+	return scannerExample1Static{
+		Rows: rows,
+	}
+}
+
+// scannerExample1Static generated by genieql
+type scannerExample1Static struct {
+	Rows *sql.Rows
+}
+
+// Scan generated by genieql
+func (t scannerExample1Static) Scan(c *StructC) error {
+	var (
+		c0 sql.NullInt64
+		c1 sql.NullString
+	)
+
+	if err := t.Rows.Scan(&c0, &c1); err != nil {
+		return err
+	}
+
+	if c0.Valid {
+		tmp := int(c0.Int64)
+		c.A = tmp
+	}
+
+	if c1.Valid {
+		tmp := c1.String
+		c.Status = Status(tmp)
+	}
+
+	return t.Rows.Err()
+}
+
+// Err generated by genieql
+func (t scannerExample1Static) Err() error {
+	return t.Rows.Err()
+}
+
+// Close generated by genieql
+func (t scannerExample1Static) Close() error {
+	if t.Rows == nil {
+		return nil
+	}
+	return t.Rows.Close()
+}
+
+// Next generated by genieql
+func (t scannerExample1Static) Next() bool {
+	return t.Rows.Next()
+}
+
+// NewScannerExample1StaticRow creates a scanner that operates on a static
+// set of columns that are always returned in the same order, only scans a single row.
+func NewScannerExample1StaticRow(row) ScannerExample1StaticRow {
+	return ScannerExample1StaticRow{
+		row: row,
+	}
+}
+
+// ScannerExample1StaticRow generated by genieql
+type ScannerExample1StaticRow struct {
+	err error
+	row
+}
+
+// Scan generated by genieql
+func (t ScannerExample1StaticRow) Scan(c *StructC) error {
+	var (
+		c0 sql.NullInt64
+		c1 sql.NullString
+	)
+
+	if t.err != nil {
+		return t.err
+	}
+
+	if err := t.row.Scan(&c0, &c1); err != nil {
+		return err
+	}
+
+	if c0.Valid {
+		tmp := int(c0.Int64)
+		c.A = tmp
+	}
+
+	if c1.Valid {
+		tmp := c1.String
+		c.Status = Status(tmp)
+	}
+
+	return nil
+}
+
+// Err set an error to return by scan
+func (t ScannerExample1StaticRow) Err(err error) ScannerExample1StaticRow {
+	t.err = err
+	return t
+}
+
+// NewScannerExample1Dynamic creates a scanner that operates on a dynamic
+// set of columns that can be returned in any subset/order.
+func NewScannerExample1Dynamic(rows *sql.Rows, err error) ScannerExample1 {
+	if err != nil {
+		return errScannerExample1{e: err}
+	}
+
+	return scannerExample1Dynamic{
+		Rows: rows,
+	}
+}
+
+// scannerExample1Dynamic generated by genieql
+type scannerExample1Dynamic struct {
+	Rows *sql.Rows
+}
+
+// Scan generated by genieql
+func (t scannerExample1Dynamic) Scan(c *StructC) error {
+	const (
+		cn0 = "a"
+		cn1 = "status"
+	)
+	var (
+		ignored sql.RawBytes
+		err     error
+		columns []string
+		dst     []interface{}
+		c0      sql.NullInt64
+		c1      sql.NullString
+	)
+
+	if columns, err = t.Rows.Columns(); err != nil {
+		return err
+	}
+
+	dst = make([]interface{}, 0, len(columns))
+
+	for _, column := range columns {
+		switch column {
+		case cn0:
+			dst = append(dst, &c0)
+		case cn1:
+			dst = append(dst, &c1)
+		default:
+			dst = append(dst, &ignored)
+		}
+	}
+
+	if err := t.Rows.Scan(dst...); err != nil {
+		return err
+	}
+
+	for _, column := range columns {
+		switch column {
+		case cn0:
+			if c0.Valid {
+				tmp := int(c0.Int64)
+				c.A = tmp
+			}
+
+		case cn1:
+			if c1.Valid {
+				tmp := c1.String
+				c.Status = Status(tmp)
+			}
+
+		}
+	}
+
+	return t.Rows.Err()
+}
+
+// Err generated by genieql
+func (t scannerExample1Dynamic) Err() error {
+	return t.Rows.Err()
+}
+
+// Close generated by genieql
+func (t scannerExample1Dynamic) Close() error {
+	if t.Rows == nil {
+		return nil
+	}
+	return t.Rows.Close()
+}
+
+// Next generated by genieql
+func (t scannerExample1Dynamic) Next() bool {
+	return t.Rows.Next()
+}
@@ -0,0 +1,407 @@
+package example
+
+import (
+	"database/sql"
+	"iter"
+)
+
+// ScannerExample2 scanner interface.
+type ScannerExample2 interface {
+	Scan(a *StructA) error
+	Next() bool
+	Close() error
+	Err() error
+}
+
+type errScannerExample2 struct {
+	e error
+}
+
+func (t errScannerExample2) Scan(a *StructA) error {
+	return t.e
+}
+
+func (t errScannerExample2) Next() bool {
+	return false
+}
+
+func (t errScannerExample2) Err() error {
+	return t.e
+}
+
+func (t errScannerExample2) Close() error {
+	return nil
+}
+
+// ScannerExample2StaticColumns generated by genieql
+const ScannerExample2StaticColumns = `"a","b","c","d","e","f","g","h"`
+
+// NewScannerExample2Static creates a scanner that operates on a static
+// set of columns that are always returned in the same order.
+func NewScannerExample2Static(rows *sql.Rows, err error) ScannerExample2 {
+	if err != nil {
+		return errScannerExample2{e: err}
+	}
+
+	return scannerExample2Static{
+		Rows: rows,
+	}
+}
+
+// scannerExample2Static generated by genieql
+type scannerExample2Static struct {
+	Rows *sql.Rows
+}
+
+// Scan generated by genieql
+func (t scannerExample2Static) Scan(a *StructA) error {
+	var (
+		c0 sql.NullInt64
+		c1 sql.NullInt64
+		c2 sql.NullInt64
+		c3 sql.NullBool
+		c4 sql.NullBool
+		c5 sql.NullBool
+		c6 sql.NullInt64
+		c7 sql.NullBool
+	)
+
+	if err := t.Rows.Scan(&c0, &c1, &c2, &c3, &c4, &c5, &c6, &c7); err != nil {
+		return err
+	}
+
+	if c0.Valid {
+		tmp := int(c0.Int64)
+		a.A = tmp
+	}
+
+	if c1.Valid {
+		tmp := int(c1.Int64)
+		a.B = tmp
+	}
+
+	if c2.Valid {
+		tmp := int(c2.Int64)
+		a.C = tmp
+	}
+
+	if c3.Valid {
+		tmp := c3.Bool
+		a.D = tmp
+	}
+
+	if c4.Valid {
+		tmp := c4.Bool
+		a.E = tmp
+	}
+
+	if c5.Valid {
+		tmp := c5.Bool
+		a.F = tmp
+	}
+
+	if c6.Valid {
+		tmp := int(c6.Int64)
+		*a.G = tmp
+	}
+
+	if c7.Valid {
+		tmp := c7.Bool
+		*a.H = tmp
+	}
+
+	return t.Rows.Err()
+}
+
+// Err generated by genieql
+func (t scannerExample2Static) Err() error {
+	return t.Rows.Err()
+}
+
+// Close generated by genieql
+func (t scannerExample2Static) Close() error {
+	if t.Rows == nil {
+		return nil
+	}
+	return t.Rows.Close()
+}
+
+// Next generated by genieql
+func (t scannerExample2Static) Next() bool {
+	return t.Rows.Next()
+}
+
+// NewScannerExample2StaticRow creates a scanner that operates on a static
+// set of columns that are always returned in the same order, only scans a single row.
+func NewScannerExample2StaticRow(row) ScannerExample2StaticRow {
+	return ScannerExample2StaticRow{
+		row: row,
+	}
+}
+
+// ScannerExample2StaticRow generated by genieql
+type ScannerExample2StaticRow struct {
+	err error
+	row
+}
+
+// Scan generated by genieql
+func (t ScannerExample2StaticRow) Scan(a *StructA) error {
+	var (
+		c0 sql.NullInt64
+		c1 sql.NullInt64
+		c2 sql.NullInt64
+		c3 sql.NullBool
+		c4 sql.NullBool
+		c5 sql.NullBool
+		c6 sql.NullInt64
+		c7 sql.NullBool
+	)
+
+	if t.err != nil {
+		return t.err
+	}
+
+	if err := t.row.Scan(&c0, &c1, &c2, &c3, &c4, &c5, &c6, &c7); err != nil {
+		return err
+	}
+
+	if c0.Valid {
+		tmp := int(c0.Int64)
+		a.A = tmp
+	}
+
+	if c1.Valid {
+		tmp := int(c1.Int64)
+		a.B = tmp
+	}
+
+	if c2.Valid {
+		tmp := int(c2.Int64)
+		a.C = tmp
+	}
+
+	if c3.Valid {
+		tmp := c3.Bool
+		a.D = tmp
+	}
+
+	if c4.Valid {
+		tmp := c4.Bool
+		a.E = tmp
+	}
+
+	if c5.Valid {
+		tmp := c5.Bool
+		a.F = tmp
+	}
+
+	if c6.Valid {
+		tmp := int(c6.Int64)
+		*a.G = tmp
+	}
+
+	if c7.Valid {
+		tmp := c7.Bool
+		*a.H = tmp
+	}
+
+	return nil
+}
+
+// Err set an error to return by scan
+func (t ScannerExample2StaticRow) Err(err error) ScannerExample2StaticRow {
+	t.err = err
+	return t
+}
+
+// NewScannerExample2Iter creates an iterator over the rows using the static
+// scanner. the rows are closed once iteration completes.
+func NewScannerExample2Iter(rows *sql.Rows, err error) iter.Seq2[StructA, error] {
+	return func(yield func(StructA, error) bool) {
+		scanner := NewScannerExample2Static(rows, err)
+		defer scanner.Close()
+
+		for scanner.Next() {
+			var v StructA
+
+			if err := scanner.Scan(&v); err != nil {
+				yield(v, err)
+				return
+			}
+
+			if !yield(v, nil) {
+				return
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			var v StructA
+			yield(v, err)
+		}
+	}
+}
+
+// ScannerExample2Collect scans all the rows returning the first error encountered.
+func ScannerExample2Collect(rows *sql.Rows, err error) (results []StructA, _ error) {
+	for v, err := range NewScannerExample2Iter(rows, err) {
+		if err != nil {
+			return results, err
+		}
+
+		results = append(results, v)
+	}
+
+	return results, nil
+}
+
+// NewScannerExample2Dynamic creates a scanner that operates on a dynamic
+// set of columns that can be returned in any subset/order.
+func NewScannerExample2Dynamic(rows *sql.Rows, err error) ScannerExample2 {
+	if err != nil {
+		return errScannerExample2{e: err}
+	}
+
+	return scannerExample2Dynamic{
+		Rows: rows,
+	}
+}
+
+// scannerExample2Dynamic generated by genieql
+type scannerExample2Dynamic struct {
+	Rows *sql.Rows
+}
+
+// Scan generated by genieql
+func (t scannerExample2Dynamic) Scan(a *StructA) error {
+	const (
+		cn0 = "a"
+		cn1 = "b"
+		cn2 = "c"
+		cn3 = "d"
+		cn4 = "e"
+		cn5 = "f"
+		cn6 = "g"
+		cn7 = "h"
+	)
+	var (
+		ignored sql.RawBytes
+		err     error
+		columns []string
+		dst     []interface{}
+		c0      sql.NullInt64
+		c1      sql.NullInt64
+		c2      sql.NullInt64
+		c3      sql.NullBool
+		c4      sql.NullBool
+		c5      sql.NullBool
+		c6      sql.NullInt64
+		c7      sql.NullBool
+	)
+
+	if columns, err = t.Rows.Columns(); err != nil {
+		return err
+	}
+
+	dst = make([]interface{}, 0, len(columns))
+
+	for _, column := range columns {
+		switch column {
+		case cn0:
+			dst = append(dst, &c0)
+		case cn1:
+			dst = append(dst, &c1)
+		case cn2:
+			dst = append(dst, &c2)
+		case cn3:
+			dst = append(dst, &c3)
+		case cn4:
+			dst = append(dst, &c4)
+		case cn5:
+			dst = append(dst, &c5)
+		case cn6:
+			dst = append(dst, &c6)
+		case cn7:
+			dst = append(dst, &c7)
+		default:
+			dst = append(dst, &ignored)
+		}
+	}
+
+	if err := t.Rows.Scan(dst...); err != nil {
+		return err
+	}
+
+	for _, column := range columns {
+		switch column {
+		case cn0:
+			if c0.Valid {
+				tmp := int(c0.Int64)
+				a.A = tmp
+			}
+
+		case cn1:
+			if c1.Valid {
+				tmp := int(c1.Int64)
+				a.B = tmp
+			}
+
+		case cn2:
+			if c2.Valid {
+				tmp := int(c2.Int64)
+				a.C = tmp
+			}
+
+		case cn3:
+			if c3.Valid {
+				tmp := c3.Bool
+				a.D = tmp
+			}
+
+		case cn4:
+			if c4.Valid {
+				tmp := c4.Bool
+				a.E = tmp
+			}
+
+		case cn5:
+			if c5.Valid {
+				tmp := c5.Bool
+				a.F = tmp
+			}
+
+		case cn6:
+			if c6.Valid {
+				tmp := int(c6.Int64)
+				*a.G = tmp
+			}
+
+		case cn7:
+			if c7.Valid {
+				tmp := c7.Bool
+				*a.H = tmp
+			}
+
+		}
+	}
+
+	return t.Rows.Err()
+}
+
+// Err generated by genieql
+func (t scannerExample2Dynamic) Err() error {
+	return t.Rows.Err()
+}
+
+// Close generated by genieql
+func (t scannerExample2Dynamic) Close() error {
+	if t.Rows == nil {
+		return nil
+	}
+	return t.Rows.Close()
+}
+
+// Next generated by genieql
+func (t scannerExample2Dynamic) Next() bool {
+	return t.Rows.Next()
+}
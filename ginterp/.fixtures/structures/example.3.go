@@ -0,0 +1,7 @@
+package example
+
+// StructureExample3 generated by genieql
+type StructureExample3 struct {
+	UUIDField string
+	CreatedAt int
+}
@@ -0,0 +1,7 @@
+package example
+
+// StructureExample4 generated by genieql
+type StructureExample4 struct {
+	UuidField string
+	CreatedAt int
+}
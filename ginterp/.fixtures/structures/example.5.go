@@ -0,0 +1,7 @@
+package example
+
+// StructureExample5 generated by genieql
+type StructureExample5 struct {
+	UUIDField UUID
+	CreatedAt int
+}
@@ -0,0 +1,7 @@
+package example
+
+// StructureExample6 generated by genieql
+type StructureExample6 struct {
+	UUIDField string `json:"uuid_field,omitempty" db:"uuid_field"`
+	CreatedAt int    `json:"created_at,omitempty" db:"created_at"`
+}
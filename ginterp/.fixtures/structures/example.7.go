@@ -0,0 +1,7 @@
+package example
+
+// StructureExample8 generated by genieql
+type StructureExample8 struct {
+	A    int
+	Mood Mood
+}
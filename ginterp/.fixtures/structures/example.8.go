@@ -0,0 +1,7 @@
+package example
+
+// StructureExample10 generated by genieql
+type StructureExample10 struct {
+	A    int
+	Mood string
+}
@@ -0,0 +1,96 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// UpdateBatchExample3 generated by genieql
+func NewUpdateBatchExample3(ctx context.Context, q sqlx.Queryer, a ...StructA) ExampleScanner {
+	return &updateBatchExample3{ctx: ctx, q: q, remaining: a}
+}
+
+type updateBatchExample3 struct {
+	ctx       context.Context
+	q         sqlx.Queryer
+	remaining []StructA
+	scanner   ExampleScanner
+}
+
+func (t *updateBatchExample3) Scan(a *StructA) error {
+	return t.scanner.Scan(a)
+}
+
+func (t *updateBatchExample3) Err() error {
+	if t.scanner == nil {
+		return nil
+	}
+	return t.scanner.Err()
+}
+
+func (t *updateBatchExample3) Close() error {
+	if t.scanner == nil {
+		return nil
+	}
+	return t.scanner.Close()
+}
+
+func (t *updateBatchExample3) Next() bool {
+	var advanced bool
+	if t.scanner != nil && t.scanner.Next() {
+		return true
+	}
+	if len(t.remaining) > 0 && t.Close() == nil {
+		t.scanner, t.remaining, advanced = t.advance(t.remaining...)
+		return advanced && t.scanner.Next()
+	}
+	return false
+}
+
+func (t *updateBatchExample3) advance(a ...StructA) (ExampleScanner, []StructA, bool) {
+	transform := func(a StructA) (c0 sql.NullInt64, c1 sql.NullInt64, c2 sql.NullBool, c3 sql.NullBool, c4 sql.NullBool, c5 sql.NullInt64, c6 sql.NullBool, err error) {
+		c0.Valid = true
+		c0.Int64 = int64(a.A)
+		c1.Valid = true
+		c1.Int64 = int64(a.C)
+		c2.Valid = true
+		c2.Bool = a.D
+		c3.Valid = true
+		c3.Bool = a.E
+		c4.Valid = true
+		c4.Bool = a.F
+		c5.Valid = true
+		c5.Int64 = int64(*a.G)
+		c6.Valid = true
+		c6.Bool = *a.H
+		return c0, c1, c2, c3, c4, c5, c6, nil
+	}
+	if len(a) == 0 {
+		return nil, []StructA(nil), false
+	}
+	n := len(a)
+	const query = `UPDATE foo AS t SET d = v.d, e = v.e, f = v.f, g = v.g, h = v.h FROM unnest($1::int8[], $2::int8[], $3::bool[], $4::bool[], $5::bool[], $6::int8[], $7::bool[]) AS v(a,c,d,e,f,g,h) WHERE t.a = v.a AND t.c = v.c RETURNING t.a,t.b,t.c,t.d,t.e,t.f,t.g,t.h`
+	v0 := make([]sql.NullInt64, 0, n)
+	v1 := make([]sql.NullInt64, 0, n)
+	v2 := make([]sql.NullBool, 0, n)
+	v3 := make([]sql.NullBool, 0, n)
+	v4 := make([]sql.NullBool, 0, n)
+	v5 := make([]sql.NullInt64, 0, n)
+	v6 := make([]sql.NullBool, 0, n)
+	for i := range n {
+		c0, c1, c2, c3, c4, c5, c6, err := transform(a[i])
+		if err != nil {
+			return NewExampleScannerStatic(nil, err), []StructA(nil), false
+		}
+		v0 = append(v0, c0)
+		v1 = append(v1, c1)
+		v2 = append(v2, c2)
+		v3 = append(v3, c3)
+		v4 = append(v4, c4)
+		v5 = append(v5, c5)
+		v6 = append(v6, c6)
+	}
+	return NewExampleScannerStatic(t.q.QueryContext(t.ctx, query, v0, v1, v2, v3, v4, v5, v6)), a[n:], true
+}
@@ -0,0 +1,41 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// UpdateExample1 generated by genieql
+// Basic Update Example
+func UpdateExample1(ctx context.Context, q sqlx.Queryer, id int, a StructA) ExampleRowScanner {
+	const query = `UPDATE foo SET b = $1, c = $2, d = $3, e = $4, f = $5, g = $6, h = $7 WHERE a = $8 RETURNING a,b,c,d,e,f,g,h`
+	var (
+		c0 sql.NullInt64 // b
+		c1 sql.NullInt64 // c
+		c2 sql.NullBool  // d
+		c3 sql.NullBool  // e
+		c4 sql.NullBool  // f
+		c5 sql.NullInt64 // g
+		c6 sql.NullBool  // h
+		c7 sql.NullInt64 // id
+	)
+	c0.Valid = true
+	c0.Int64 = int64(a.B)
+	c1.Valid = true
+	c1.Int64 = int64(a.C)
+	c2.Valid = true
+	c2.Bool = a.D
+	c3.Valid = true
+	c3.Bool = a.E
+	c4.Valid = true
+	c4.Bool = a.F
+	c5.Valid = true
+	c5.Int64 = int64(*a.G)
+	c6.Valid = true
+	c6.Bool = *a.H
+	c7.Valid = true
+	c7.Int64 = int64(id)
+	return NewExampleScannerStaticRow(q.QueryRowContext(ctx, query, c0, c1, c2, c3, c4, c5, c6, c7))
+}
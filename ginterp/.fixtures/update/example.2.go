@@ -0,0 +1,40 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// UpdateExample2 generated by genieql
+func UpdateExample2(ctx context.Context, q sqlx.Queryer, a StructA) ExampleRowScanner {
+	const query = `UPDATE foo SET c = $1, d = $2, e = $3, f = $4, g = $5, h = $6 WHERE a = $7 AND b = $8 RETURNING a,b,c,d,e,f,g,h`
+	var (
+		c0 sql.NullInt64 // c
+		c1 sql.NullBool  // d
+		c2 sql.NullBool  // e
+		c3 sql.NullBool  // f
+		c4 sql.NullInt64 // g
+		c5 sql.NullBool  // h
+		c6 sql.NullInt64 // a
+		c7 sql.NullInt64 // b
+	)
+	c0.Valid = true
+	c0.Int64 = int64(a.C)
+	c1.Valid = true
+	c1.Bool = a.D
+	c2.Valid = true
+	c2.Bool = a.E
+	c3.Valid = true
+	c3.Bool = a.F
+	c4.Valid = true
+	c4.Int64 = int64(*a.G)
+	c5.Valid = true
+	c5.Bool = *a.H
+	c6.Valid = true
+	c6.Int64 = int64(a.A)
+	c7.Valid = true
+	c7.Int64 = int64(a.B)
+	return NewExampleScannerStaticRow(q.QueryRowContext(ctx, query, c0, c1, c2, c3, c4, c5, c6, c7))
+}
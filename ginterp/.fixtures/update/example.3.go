@@ -0,0 +1,22 @@
+package example
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/james-lawrence/genieql/internal/sqlx"
+)
+
+// UpdateExample3 generated by genieql
+func UpdateExample3(ctx context.Context, q sqlx.Queryer, a StructD) ExampleRowScanner {
+	const query = `UPDATE struct_d SET a = $1 WHERE a = $2 RETURNING a,total`
+	var (
+		c0 sql.NullInt64 // a
+		c1 sql.NullInt64 // a
+	)
+	c0.Valid = true
+	c0.Int64 = int64(a.A)
+	c1.Valid = true
+	c1.Int64 = int64(a.A)
+	return NewExampleScannerStaticRow(q.QueryRowContext(ctx, query, c0, c1))
+}
@@ -0,0 +1,98 @@
+package ginterp
+
+import (
+	"slices"
+
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/generators"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+)
+
+// Upsert configuration interface for resolving insert conflicts.
+type Upsert[T any] interface {
+	DoNothing() T               // leave the conflicting row untouched.
+	DoUpdate(...string) T       // update the specified columns, when none are specified all inserted columns are updated.
+	DoUpdateExcept(...string) T // update all inserted columns except the specified columns.
+}
+
+type conflictAction int
+
+const (
+	conflictDoNothing conflictAction = iota
+	conflictDoUpdate
+	conflictDoUpdateExcept
+)
+
+// conflict structured description of an upsert, rendered by the dialect.
+type conflict struct {
+	target  []string
+	action  conflictAction
+	columns []string
+}
+
+// clause validates the conflict against the table and renders it using the dialect.
+// inserted are the columns being written by the insert.
+func (t conflict) clause(ctx generators.Context, table string, inserted []string) (_ string, err error) {
+	var (
+		details genieql.TableDetails
+		updates []string
+	)
+
+	if len(t.target) == 0 {
+		return "", errorsx.String("conflict target columns are required. use OnConflict to specify the columns")
+	}
+
+	if details, err = genieql.LookupTableDetails(ctx.Driver, ctx.Dialect, table); err != nil {
+		return "", err
+	}
+
+	known := genieql.ColumnInfoSet(details.Columns).ColumnNames()
+	for _, column := range append(slices.Clone(t.target), t.columns...) {
+		if !slices.Contains(known, column) {
+			return "", errorsx.Errorf("conflict column %s does not exist in table %s", column, table)
+		}
+	}
+
+	switch t.action {
+	case conflictDoUpdate:
+		updates = t.columns
+		if len(updates) == 0 {
+			updates = exclude(inserted, t.target...)
+		}
+	case conflictDoUpdateExcept:
+		updates = exclude(inserted, append(slices.Clone(t.target), t.columns...)...)
+	}
+
+	return ctx.Dialect.Conflict(t.target, updates), nil
+}
+
+type upsert[T any] struct {
+	parent T
+	dst    *conflict
+}
+
+// DoNothing ignore the conflicting rows.
+func (t upsert[T]) DoNothing() T {
+	t.dst.action = conflictDoNothing
+	return t.parent
+}
+
+// DoUpdate update the specified columns of the conflicting rows.
+func (t upsert[T]) DoUpdate(columns ...string) T {
+	t.dst.action = conflictDoUpdate
+	t.dst.columns = columns
+	return t.parent
+}
+
+// DoUpdateExcept update all inserted columns of the conflicting rows except the specified columns.
+func (t upsert[T]) DoUpdateExcept(columns ...string) T {
+	t.dst.action = conflictDoUpdateExcept
+	t.dst.columns = columns
+	return t.parent
+}
+
+func exclude(columns []string, excluded ...string) []string {
+	return slices.DeleteFunc(slices.Clone(columns), func(c string) bool {
+		return slices.Contains(excluded, c)
+	})
+}
@@ -0,0 +1,257 @@
+package ginterp
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/generators"
+	"github.com/james-lawrence/genieql/generators/functions"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+)
+
+// Copy configuration interface for generating bulk inserts using the postgresql
+// COPY FROM protocol. the generated function accepts a slice or an iter.Seq of the
+// type and returns the number of rows copied.
+// i.e.) func(ctx context.Context, conn *pgx.Conn, rows []Type)
+type Copy interface {
+	genieql.Generator       // must satisfy the generator interface
+	Into(string) Copy       // what table to copy into
+	Default(...string) Copy // columns to omit, the database default is used.
+}
+
+func CopyFromFile(cctx generators.Context, name string, tree *ast.File) (Copy, error) {
+	var (
+		ok          bool
+		declPattern *ast.FuncType
+		pos         *ast.FuncDecl
+		cf          *ast.Field
+		qf          *ast.Field
+		params      []*ast.Field
+	)
+
+	if pos = astcodec.FileFindDecl[*ast.FuncDecl](tree, astcodec.FindFunctionsByName(name)); pos == nil {
+		return nil, fmt.Errorf("unable to locate function declaration for copy: %s", name)
+	}
+
+	if declPattern, ok = pos.Type.Params.List[1].Type.(*ast.FuncType); !ok {
+		return nil, errorsx.String("genieql.Copy second parameter must be a function type")
+	}
+
+	if cf = functions.DetectContext(declPattern); cf != nil {
+		declPattern.Params.List = declPattern.Params.List[1:]
+	}
+
+	if qf = functions.DetectQueryer(declPattern); qf != nil {
+		declPattern.Params.List = declPattern.Params.List[1:]
+	}
+
+	if params = astutil.FlattenFields(declPattern.Params.List...); qf == nil || len(params) != 1 {
+		return nil, errorsx.Errorf("genieql.Copy %s - expected a connection and the rows to copy; i.e.) func(ctx context.Context, conn *pgx.Conn, rows []Type)", nodeInfo(cctx, pos))
+	}
+
+	return NewCopy(
+		cctx,
+		pos.Name.String(),
+		pos.Doc,
+		cf,
+		qf,
+		params[0],
+	), nil
+}
+
+// NewCopy instantiate a new copy generator. it uses the name of function
+// that calls Define as the name of the generated function.
+// the rows field must be a slice or an iter.Seq of the type being copied.
+func NewCopy(
+	ctx generators.Context,
+	name string,
+	comment *ast.CommentGroup,
+	cf *ast.Field,
+	qf *ast.Field,
+	rows *ast.Field,
+) Copy {
+	return &copyfrom{
+		ctx:     ctx,
+		name:    name,
+		comment: comment,
+		cf:      sanitizeCopyField(cf),
+		qf:      sanitizeCopyField(qf),
+		rows:    sanitizeCopyField(rows),
+	}
+}
+
+// sanitizeCopyField prevents the parameters from colliding with the
+// local variables of the generated function.
+func sanitizeCopyField(f *ast.Field) *ast.Field {
+	if f == nil {
+		return nil
+	}
+
+	return generators.SanitizeFieldIdents(func(i *ast.Ident) *ast.Ident {
+		switch i.Name {
+		case "next", "stop", "v", "ok", "i":
+			return ast.NewIdent("_genieql_" + i.Name)
+		}
+
+		return i
+	}, f)[0]
+}
+
+type copyfrom struct {
+	ctx      generators.Context
+	name     string
+	table    string
+	defaults []string
+	cf       *ast.Field // context field, can be nil.
+	qf       *ast.Field // connection field.
+	rows     *ast.Field // rows being copied.
+	comment  *ast.CommentGroup
+}
+
+// Into specify the table the rows will be copied into.
+func (t *copyfrom) Into(s string) Copy {
+	t.table = s
+	return t
+}
+
+// Default specify the table columns to be given their default values.
+func (t *copyfrom) Default(defaults ...string) Copy {
+	t.defaults = defaults
+	return t
+}
+
+// copyDialect the dialect supporting COPY FROM.
+const copyDialect = "postgres"
+
+func (t *copyfrom) Generate(dst io.Writer) (err error) {
+	const tmpl = `func {{.Name}}({{ if .Context }}{{ .ContextName }} {{ .Context }}, {{ end }}{{ .QueryerName }} {{ .Queryer }}, {{ .Rows }} {{ .RowsType }}) (int64, error) {
+	{{- if .Iterator }}
+	next, stop := iter.Pull({{ .Rows }})
+	defer stop()
+
+	return {{ .QueryerName }}.CopyFrom({{ .ContextExpr }}, pgx.Identifier{ {{ .Table }} }, []string{ {{ .Columns }} }, pgx.CopyFromFunc(func() ([]any, error) {
+		v, ok := next()
+		if !ok {
+			return nil, nil
+		}
+
+		return {{ .Explode }}(&v)
+	}))
+	{{- else }}
+	return {{ .QueryerName }}.CopyFrom({{ .ContextExpr }}, pgx.Identifier{ {{ .Table }} }, []string{ {{ .Columns }} }, pgx.CopyFromSlice(len({{ .Rows }}), func(i int) ([]any, error) {
+		return {{ .Explode }}(&{{ .Rows }}[i])
+	}))
+	{{- end }}
+}
+`
+	type context struct {
+		Name        string
+		Context     string
+		ContextName string
+		ContextExpr string
+		Queryer     string
+		QueryerName string
+		Rows        string
+		RowsType    string
+		Iterator    bool
+		Explode     string
+		Table       string
+		Columns     string
+	}
+
+	var (
+		typ      ast.Expr
+		iterator bool
+		cmaps    []genieql.ColumnMap
+		columns  []string
+		table    []string
+	)
+
+	t.ctx.Println("generation of", t.name, "initiated")
+	defer t.ctx.Println("generation of", t.name, "completed")
+	t.ctx.Debugln("copy table", t.table)
+
+	if strings.TrimSpace(t.table) == "" {
+		return errorsx.Errorf("genieql.Copy %s - table is required. use Into method to specify a table", t.name)
+	}
+
+	// the generated code uses the pgx COPY protocol.
+	if t.ctx.Configuration.Dialect != copyDialect {
+		return errorsx.Errorf("genieql.Copy %s - COPY FROM is only supported by %s, found %s", t.name, copyDialect, t.ctx.Configuration.Dialect)
+	}
+
+	switch x := t.rows.Type.(type) {
+	case *ast.ArrayType:
+		if x.Len == nil {
+			typ = x.Elt
+		}
+	case *ast.IndexExpr:
+		if types.ExprString(x.X) == "iter.Seq" {
+			typ, iterator = x.Index, true
+		}
+	}
+
+	if typ == nil {
+		return errorsx.Errorf("genieql.Copy %s - rows must be a slice or an iter.Seq, found %s", t.name, types.ExprString(t.rows.Type))
+	}
+
+	param := astutil.Field(typ, ast.NewIdent("v"))
+	if cmaps, err = generators.ColumnMapFromFields(t.ctx, param); err != nil {
+		return errorsx.Wrap(err, "unable to generate mapping")
+	}
+
+	// generated columns are computed by the database, as such they're never copied.
+	cset := genieql.ColumnMapSet(cmaps).Filter(func(cm genieql.ColumnMap) bool {
+		return genieql.ColumnInfoFilterIgnore(t.defaults...)(cm.ColumnInfo) && genieql.NotGeneratedFilter(cm.ColumnInfo)
+	})
+
+	if len(cset) == 0 {
+		return errorsx.Errorf("genieql.Copy %s - no columns to copy", t.name)
+	}
+
+	for _, c := range cset {
+		columns = append(columns, fmt.Sprintf("%q", c.ColumnInfo.Name))
+	}
+
+	for _, segment := range strings.Split(t.table, ".") {
+		table = append(table, fmt.Sprintf("%q", segment))
+	}
+
+	ctx, ctxname, ctxexpr := "", "", "context.Background()"
+	if t.cf != nil {
+		ctx, ctxname, ctxexpr = types.ExprString(t.cf.Type), t.cf.Names[0].Name, t.cf.Names[0].Name
+	}
+
+	explode := fmt.Sprintf("%sExplode", t.name)
+
+	return genieql.MultiGenerate(
+		generators.NewExploderFunction(t.ctx, param, cset, generators.QFOName(explode)),
+		genieql.NewFuncGenerator(func(dst io.Writer) (err error) {
+			if err = generators.GenerateComment(generators.DefaultFunctionComment(t.name), t.comment).Generate(dst); err != nil {
+				return err
+			}
+
+			return template.Must(template.New("copy template").Parse(tmpl)).Execute(dst, context{
+				Name:        t.name,
+				Context:     ctx,
+				ContextName: ctxname,
+				ContextExpr: ctxexpr,
+				Queryer:     types.ExprString(t.qf.Type),
+				QueryerName: t.qf.Names[0].Name,
+				Rows:        t.rows.Names[0].Name,
+				RowsType:    types.ExprString(t.rows.Type),
+				Iterator:    iterator,
+				Explode:     explode,
+				Table:       strings.Join(table, ", "),
+				Columns:     strings.Join(columns, ", "),
+			})
+		}),
+	).Generate(dst)
+}
@@ -0,0 +1,106 @@
+package ginterp_test
+
+import (
+	"bytes"
+	"go/ast"
+	"io"
+
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/genieqltest"
+	. "github.com/james-lawrence/genieql/ginterp"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/membufx"
+	"github.com/james-lawrence/genieql/internal/testx"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Copy", func() {
+	config := DialectConfig1()
+	ctx, err := genieqltest.GeneratorContext(config)
+	errorsx.MaybePanic(err)
+	// COPY FROM is only generated for postgres.
+	ctx.Configuration.Dialect = "postgres"
+
+	DescribeTable(
+		"examples",
+		func(in Copy, out io.Reader) {
+			var (
+				b         = bytes.NewBufferString("package example\n")
+				formatted = bytes.NewBufferString("")
+			)
+
+			Expect(in.Generate(b)).To(Succeed())
+			Expect(astcodec.FormatOutput(formatted, b.Bytes())).To(Succeed())
+			Expect(formatted.String()).To(Equal(testx.IOString(out)))
+		},
+		Entry(
+			"example 1 - slice of rows",
+			NewCopy(
+				ctx,
+				"CopyExample1",
+				&ast.CommentGroup{
+					List: []*ast.Comment{
+						{Text: "// Basic Copy Example"},
+					},
+				},
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("*pgx.Conn"), ast.NewIdent("q")),
+				astutil.Field(astutil.Expr("[]StructA"), ast.NewIdent("rows")),
+			).Into("struct_a").Default("a"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/copy/example.1.go"))),
+		),
+		Entry(
+			"example 2 - iterator of rows without a context",
+			NewCopy(
+				ctx,
+				"CopyExample2",
+				nil,
+				nil,
+				astutil.Field(astutil.Expr("*pgx.Conn"), ast.NewIdent("conn")),
+				astutil.Field(astutil.Expr("iter.Seq[StructA]"), ast.NewIdent("v")),
+			).Into("public.struct_a"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/copy/example.2.go"))),
+		),
+	)
+
+	It("should require a table", func() {
+		gen := NewCopy(
+			ctx,
+			"CopyExample3",
+			nil,
+			astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+			astutil.Field(astutil.Expr("*pgx.Conn"), ast.NewIdent("q")),
+			astutil.Field(astutil.Expr("[]StructA"), ast.NewIdent("rows")),
+		)
+		Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("table is required")))
+	})
+
+	It("should reject rows that are not a slice or an iterator", func() {
+		gen := NewCopy(
+			ctx,
+			"CopyExample4",
+			nil,
+			astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+			astutil.Field(astutil.Expr("*pgx.Conn"), ast.NewIdent("q")),
+			astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("rows")),
+		).Into("struct_a")
+		Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("rows must be a slice or an iter.Seq, found StructA")))
+	})
+
+	It("should reject dialects other than postgres", func() {
+		unsupported := ctx
+		unsupported.Configuration.Dialect = "sqlite3"
+		gen := NewCopy(
+			unsupported,
+			"CopyExample5",
+			nil,
+			astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+			astutil.Field(astutil.Expr("*pgx.Conn"), ast.NewIdent("q")),
+			astutil.Field(astutil.Expr("[]StructA"), ast.NewIdent("rows")),
+		).Into("struct_a")
+		Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("COPY FROM is only supported by postgres, found sqlite3")))
+	})
+})
@@ -0,0 +1,146 @@
+package ginterp
+
+import (
+	"fmt"
+	"go/ast"
+	"io"
+	"strings"
+
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/generators"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+)
+
+// DeleteBatch configuration interface for generating batch deletes.
+// the records are deleted by a single statement joining the table against
+// the predicate values of the records, the deleted rows stream through the scanner.
+type DeleteBatch interface {
+	genieql.Generator            // must satisfy the generator interface
+	Table(string) DeleteBatch    // what table to delete from
+	Where(...string) DeleteBatch // columns used to match the records to the rows of the table.
+}
+
+func DeleteBatchFromFile(cctx generators.Context, name string, tree *ast.File) (DeleteBatch, error) {
+	var (
+		pos     *ast.FuncDecl
+		scanner *ast.FuncDecl
+		cf      *ast.Field
+		qf      *ast.Field
+		tf      *ast.Field
+		err     error
+	)
+
+	if pos = astcodec.FileFindDecl[*ast.FuncDecl](tree, astcodec.FindFunctionsByName(name)); pos == nil {
+		return nil, fmt.Errorf("unable to locate function declaration for delete batch: %s", name)
+	}
+
+	if scanner, cf, qf, tf, err = batchModifyFields(cctx, "genieql.DeleteBatch", pos); err != nil {
+		return nil, err
+	}
+
+	return NewDeleteBatch(
+		cctx,
+		pos.Name.String(),
+		pos.Doc,
+		cf,
+		qf,
+		tf,
+		scanner,
+	), nil
+}
+
+// NewDeleteBatch instantiate a new batch delete generator. it uses the name of function
+// that calls Define as the name of the generated function.
+func NewDeleteBatch(
+	ctx generators.Context,
+	name string,
+	comment *ast.CommentGroup,
+	cf *ast.Field,
+	qf *ast.Field,
+	tf *ast.Field,
+	scanner *ast.FuncDecl,
+) DeleteBatch {
+	return &deletebatch{
+		ctx:     ctx,
+		name:    name,
+		comment: comment,
+		cf:      sanitizeBatchModifyField(cf),
+		qf:      sanitizeBatchModifyField(qf),
+		tf:      sanitizeBatchModifyField(tf),
+		scanner: scanner,
+	}
+}
+
+type deletebatch struct {
+	ctx     generators.Context
+	name    string
+	table   string
+	where   []string
+	tf      *ast.Field    // type field.
+	cf      *ast.Field    // context field, can be nil.
+	qf      *ast.Field    // db Query field.
+	scanner *ast.FuncDecl // scanner being used for results.
+	comment *ast.CommentGroup
+}
+
+// Table specify the table being deleted from.
+func (t *deletebatch) Table(s string) DeleteBatch {
+	t.table = s
+	return t
+}
+
+// Where specify the table columns used to match the records to rows.
+func (t *deletebatch) Where(columns ...string) DeleteBatch {
+	t.where = columns
+	return t
+}
+
+func (t *deletebatch) Generate(dst io.Writer) (err error) {
+	var (
+		cmaps []genieql.ColumnMap
+		keys  []genieql.ColumnMap
+	)
+
+	t.ctx.Println("generation of", t.name, "initiated")
+	defer t.ctx.Println("generation of", t.name, "completed")
+	t.ctx.Debugln("delete batch table", t.table)
+
+	if strings.TrimSpace(t.table) == "" {
+		return errorsx.Errorf("genieql.DeleteBatch %s - table is required. use Table method to specify a table", t.name)
+	}
+
+	if err = returning(t.ctx, "genieql.DeleteBatch", t.name); err != nil {
+		return err
+	}
+
+	if len(t.where) == 0 {
+		return errorsx.Errorf("genieql.DeleteBatch %s - predicate columns are required. use Where method to specify the columns", t.name)
+	}
+
+	if cmaps, err = generators.ColumnMapFromFields(t.ctx, t.tf); err != nil {
+		return errorsx.Wrap(err, "unable to generate mapping")
+	}
+
+	cset := genieql.ColumnMapSet(cmaps)
+	if keys, err = batchModifyKeys(cset, t.where); err != nil {
+		return errorsx.Wrapf(err, "genieql.DeleteBatch %s", t.name)
+	}
+
+	values := genieql.ColumnMapSet(keys)
+	statement := func(n int) string {
+		return t.ctx.Dialect.DeleteBatch(n, t.table, values.ColumnNames(), batchModifyTypes(values), cset.ColumnNames())
+	}
+
+	return batchModify{
+		ctx:       t.ctx,
+		name:      t.name,
+		comment:   t.comment,
+		cf:        t.cf,
+		qf:        t.qf,
+		tf:        t.tf,
+		scanner:   t.scanner,
+		values:    values,
+		statement: statement,
+	}.Generate(dst)
+}
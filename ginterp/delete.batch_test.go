@@ -0,0 +1,105 @@
+package ginterp_test
+
+import (
+	"bytes"
+	"go/ast"
+	"go/token"
+	"io"
+
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/genieqltest"
+	. "github.com/james-lawrence/genieql/ginterp"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/membufx"
+	"github.com/james-lawrence/genieql/internal/testx"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Batch Delete", func() {
+	rowsScanner := &ast.FuncDecl{
+		Name: ast.NewIdent("NewExampleScannerStatic"),
+		Type: astutil.MustParseExpr(token.NewFileSet(), "func(rows *sql.Rows, err error) ExampleScanner").(*ast.FuncType),
+	}
+
+	ctx, err := genieqltest.GeneratorContext(DialectConfig1())
+	errorsx.MaybePanic(err)
+	noreturning, err := genieqltest.GeneratorContext(DialectConfig7())
+	errorsx.MaybePanic(err)
+	arrays, err := genieqltest.GeneratorContext(DialectConfig3())
+	errorsx.MaybePanic(err)
+
+	DescribeTable(
+		"examples",
+		func(in DeleteBatch, out io.Reader) {
+			var (
+				b         = bytes.NewBufferString("package example\n")
+				formatted = bytes.NewBufferString("")
+			)
+
+			Expect(in.Generate(b)).To(Succeed())
+			Expect(astcodec.FormatOutput(formatted, b.Bytes())).To(Succeed())
+			Expect(formatted.String()).To(Equal(testx.IOString(out)))
+		},
+		Entry(
+			"example 1 - batch delete",
+			NewDeleteBatch(
+				ctx,
+				"DeleteBatchExample1",
+				nil,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				rowsScanner,
+			).Table("foo").Where("a"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/delete.batch/example.1.go"))),
+		),
+		Entry(
+			"example 2 - batch delete binding arrays",
+			NewDeleteBatch(
+				arrays,
+				"DeleteBatchExample2",
+				nil,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				rowsScanner,
+			).Table("foo").Where("a", "c"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/delete.batch/example.2.go"))),
+		),
+	)
+
+	DescribeTable(
+		"errors",
+		func(in DeleteBatch, expected string) {
+			Expect(in.Generate(io.Discard)).To(MatchError(ContainSubstring(expected)))
+		},
+		Entry(
+			"missing predicates",
+			NewDeleteBatch(
+				ctx,
+				"DeleteBatchExample1",
+				nil,
+				nil,
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				rowsScanner,
+			).Table("foo"),
+			"predicate columns are required",
+		),
+		Entry(
+			"dialect unable to return the deleted rows",
+			NewDeleteBatch(
+				noreturning,
+				"DeleteBatchExample1",
+				nil,
+				nil,
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				rowsScanner,
+			).Table("foo").Where("a"),
+			"the dialect is unable to return the modified rows",
+		),
+	)
+})
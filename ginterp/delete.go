@@ -0,0 +1,209 @@
+package ginterp
+
+import (
+	"fmt"
+	"go/ast"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/serenize/snaker"
+
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/generators"
+	"github.com/james-lawrence/genieql/generators/functions"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+)
+
+// Delete configuration interface for generating Delete.
+type Delete interface {
+	genieql.Generator       // must satisfy the generator interface
+	Table(string) Delete    // what table to delete from
+	Where(...string) Delete // columns used to select the rows to delete.
+}
+
+func DeleteFromFile(cctx generators.Context, name string, tree *ast.File) (Delete, error) {
+	var (
+		ok          bool
+		declPattern *ast.FuncType
+		pos         *ast.FuncDecl
+		scanner     *ast.FuncDecl // scanner to use for the results.
+		cf          *ast.Field
+		qf          *ast.Field
+	)
+
+	if pos = astcodec.FileFindDecl[*ast.FuncDecl](tree, astcodec.FindFunctionsByName(name)); pos == nil {
+		return nil, fmt.Errorf("unable to locate function declaration for delete: %s", name)
+	}
+
+	// rewrite scanner declaration function.
+	if declPattern, ok = pos.Type.Params.List[1].Type.(*ast.FuncType); !ok {
+		return nil, errorsx.String("genieql.Delete second parameter must be a function type")
+	}
+
+	if scanner = functions.DetectScanner(cctx, declPattern); scanner == nil {
+		return nil, errorsx.Errorf("genieql.Delete %s - missing scanner", nodeInfo(cctx, pos))
+	}
+
+	if cf = functions.DetectContext(declPattern); cf != nil {
+		declPattern.Params.List = declPattern.Params.List[1:]
+	}
+
+	if qf = functions.DetectQueryer(declPattern); qf != nil {
+		declPattern.Params.List = declPattern.Params.List[1:]
+	}
+
+	return NewDelete(
+		cctx,
+		pos.Name.String(),
+		pos.Doc,
+		scanner,
+		cf,
+		qf,
+		declPattern.Params.List...,
+	), nil
+}
+
+// NewDelete instantiate a new delete generator. it uses the name of function
+// that calls Define as the name of the generated function.
+// the parameters are matched by column name to the columns specified by Where.
+// when Where is not specified the parameter names are used as the columns.
+func NewDelete(
+	ctx generators.Context,
+	name string,
+	comment *ast.CommentGroup,
+	scanner *ast.FuncDecl,
+	cf *ast.Field,
+	qf *ast.Field,
+	params ...*ast.Field,
+) Delete {
+	return &deletion{
+		ctx:     ctx,
+		name:    name,
+		comment: comment,
+		qf:      qf,
+		cf:      cf,
+		params:  params,
+		scanner: scanner,
+	}
+}
+
+type deletion struct {
+	ctx     generators.Context
+	name    string
+	table   string
+	where   []string
+	params  []*ast.Field
+	cf      *ast.Field    // context field, can be nil.
+	qf      *ast.Field    // db Query field.
+	scanner *ast.FuncDecl // scanner being used for results.
+	comment *ast.CommentGroup
+}
+
+// Table specify the table being deleted from.
+func (t *deletion) Table(s string) Delete {
+	t.table = s
+	return t
+}
+
+// Where specify the table columns used to select the rows to delete.
+func (t *deletion) Where(columns ...string) Delete {
+	t.where = columns
+	return t
+}
+
+func (t *deletion) Generate(dst io.Writer) (err error) {
+	var (
+		details    genieql.TableDetails
+		cmaps      []genieql.ColumnMap
+		keycmaps   []genieql.ColumnMap
+		qinputs    []ast.Expr
+		encodings  []ast.Stmt
+		locals     []ast.Spec
+		transforms []ast.Stmt
+	)
+
+	t.ctx.Println("generation of", t.name, "initiated")
+	defer t.ctx.Println("generation of", t.name, "completed")
+	t.ctx.Debugln("delete table", t.table)
+
+	if strings.TrimSpace(t.table) == "" {
+		return errorsx.Errorf("genieql.Delete %s - table is required. use Table method to specify a table", t.name)
+	}
+
+	if err = returning(t.ctx, "genieql.Delete", t.name); err != nil {
+		return err
+	}
+
+	if cmaps, err = generators.ColumnMapFromFields(t.ctx, t.params...); err != nil {
+		return errorsx.Wrap(err, "unable to generate mapping")
+	}
+
+	cset := genieql.ColumnMapSet(cmaps)
+	where := t.where
+	if len(where) == 0 {
+		where = cset.ColumnNames()
+	}
+
+	if len(where) == 0 {
+		return errorsx.Errorf("genieql.Delete %s - predicate columns are required. use Where method or provide parameters", t.name)
+	}
+
+	for _, column := range where {
+		idx := slices.IndexFunc(cset, func(cm genieql.ColumnMap) bool {
+			// scalar parameters are named after the column. i.e.) userID provides user_id
+			return cm.ColumnInfo.Name == column || snaker.CamelToSnake(cm.ColumnInfo.Name) == column
+		})
+		if idx < 0 {
+			return errorsx.Errorf("genieql.Delete %s - predicate column %s is not provided by the parameters", t.name, column)
+		}
+		keycmaps = append(keycmaps, cset[idx])
+	}
+
+	if details, err = genieql.LookupTableDetails(t.ctx.Driver, t.ctx.Dialect, t.table); err != nil {
+		return err
+	}
+
+	if locals, encodings, qinputs, err = generators.QueryInputsFromColumnMap(t.ctx, t.scanner, nil, keycmaps...); err != nil {
+		return errorsx.Wrap(err, "unable to transform query inputs")
+	}
+
+	if len(locals) > 0 {
+		transforms = []ast.Stmt{
+			&ast.DeclStmt{
+				Decl: astutil.VarList(locals...),
+			},
+		}
+	}
+	transforms = append(transforms, encodings...)
+
+	qfn := functions.Query{
+		Context:      t.ctx,
+		Scanner:      t.scanner,
+		Queryer:      t.qf.Type,
+		Transforms:   transforms,
+		QueryInputs:  qinputs,
+		ContextField: t.cf,
+		Query: astutil.StringLiteral(
+			t.ctx.Dialect.Delete(
+				t.table,
+				genieql.ColumnInfoSet(details.Columns).ColumnNames(),
+				where,
+			),
+		),
+	}
+
+	sig := &ast.FuncType{
+		Params: &ast.FieldList{
+			List: astutil.FlattenFields(t.params...),
+		},
+	}
+
+	if err = generators.GenerateComment(generators.DefaultFunctionComment(t.name), t.comment).Generate(dst); err != nil {
+		return err
+	}
+
+	return functions.CompileInto(dst, functions.New(t.name, sig), qfn)
+}
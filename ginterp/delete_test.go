@@ -0,0 +1,142 @@
+package ginterp_test
+
+import (
+	"bytes"
+	"go/ast"
+	"go/token"
+	"io"
+
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/genieqltest"
+	. "github.com/james-lawrence/genieql/ginterp"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/membufx"
+	"github.com/james-lawrence/genieql/internal/testx"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Delete", func() {
+	rowScanner := &ast.FuncDecl{
+		Name: ast.NewIdent("NewExampleScannerStaticRow"),
+		Type: astutil.MustParseExpr(token.NewFileSet(), "func(row *sql.Row) ExampleRowScanner").(*ast.FuncType),
+	}
+	config := DialectConfig1()
+	ctx, err := genieqltest.GeneratorContext(config)
+	errorsx.MaybePanic(err)
+
+	DescribeTable(
+		"examples",
+		func(in Delete, out io.Reader) {
+			var (
+				b         = bytes.NewBufferString("package example\n")
+				formatted = bytes.NewBufferString("")
+			)
+
+			Expect(in.Generate(b)).To(Succeed())
+			Expect(astcodec.FormatOutput(formatted, b.Bytes())).To(Succeed())
+			Expect(formatted.String()).To(Equal(testx.IOString(out)))
+		},
+		Entry(
+			"example 1 - delete by key parameter",
+			NewDelete(
+				ctx,
+				"DeleteExample1",
+				&ast.CommentGroup{
+					List: []*ast.Comment{
+						{Text: "// Basic Delete Example"},
+					},
+				},
+				rowScanner,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("int"), ast.NewIdent("a")),
+			).Table("struct_a").Where("a"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/delete/example.1.go"))),
+		),
+		Entry(
+			"example 2 - delete using the fields of the structure",
+			NewDelete(
+				ctx,
+				"DeleteExample2",
+				nil,
+				rowScanner,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+			).Table("struct_a").Where("a", "b"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/delete/example.2.go"))),
+		),
+		Entry(
+			"example 3 - predicate columns are matched to the fields by name",
+			NewDelete(
+				ctx,
+				"DeleteExample3",
+				nil,
+				rowScanner,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+			).Table("struct_a").Where("b", "a"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/delete/example.3.go"))),
+		),
+		Entry(
+			"example 4 - predicate columns are matched to the parameters by name",
+			NewDelete(
+				ctx,
+				"DeleteExample4",
+				nil,
+				rowScanner,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("int"), ast.NewIdent("a")),
+				astutil.Field(ast.NewIdent("int"), ast.NewIdent("b")),
+			).Table("struct_a").Where("b", "a"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/delete/example.4.go"))),
+		),
+	)
+
+	It("should require the parameters to provide the predicate columns", func() {
+		gen := NewDelete(
+			ctx,
+			"DeleteExample5",
+			nil,
+			rowScanner,
+			astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+			astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+			astutil.Field(ast.NewIdent("int"), ast.NewIdent("id")),
+		).Table("struct_a").Where("a")
+		Expect(gen.Generate(io.Discard)).ToNot(Succeed())
+	})
+
+	It("should require a table", func() {
+		gen := NewDelete(
+			ctx,
+			"DeleteExample3",
+			nil,
+			rowScanner,
+			astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+			astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+			astutil.Field(ast.NewIdent("int"), ast.NewIdent("id")),
+		)
+		Expect(gen.Generate(io.Discard)).ToNot(Succeed())
+	})
+
+	It("should require a dialect returning the deleted rows", func() {
+		noreturning, err := genieqltest.GeneratorContext(DialectConfig7())
+		Expect(err).To(Succeed())
+
+		gen := NewDelete(
+			noreturning,
+			"DeleteExample1",
+			nil,
+			rowScanner,
+			astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+			astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+			astutil.Field(ast.NewIdent("int"), ast.NewIdent("a")),
+		).Table("struct_a").Where("a")
+		Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("the dialect is unable to return the modified rows")))
+	})
+})
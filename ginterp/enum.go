@@ -0,0 +1,97 @@
+package ginterp
+
+import (
+	"fmt"
+	"go/ast"
+	"io"
+	"slices"
+
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/generators"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+)
+
+// Enum - configuration interface for generating enumerated types.
+// the generated type is a string type with a constant for each value
+// and implements sql.Scanner and driver.Valuer.
+type Enum interface {
+	genieql.Generator // must satisfy the generator interface
+	// From generate the enumeration from the type of the column in the record definition.
+	From(d definition, column string) Enum
+	Table(string) definition
+	Query(string) definition
+}
+
+func EnumFromFile(cctx generators.Context, name string, tree *ast.File) (Enum, error) {
+	var (
+		fn *ast.FuncDecl
+	)
+
+	if fn = astcodec.FileFindDecl[*ast.FuncDecl](tree, astcodec.FindFunctionsByName(name)); fn == nil {
+		return nil, fmt.Errorf("unable to locate function declaration for enum: %s", name)
+	}
+
+	return NewEnum(
+		cctx,
+		name,
+		fn.Doc,
+	), nil
+}
+
+// NewEnum instantiate a new enum generator. it uses the name of function
+// that calls Define as the name of the emitted type. structures use the type
+// by overriding the type of the column. i.e.) gql.From(gql.Table("t")).Type("mood", "Mood")
+func NewEnum(ctx generators.Context, name string, comment *ast.CommentGroup) Enum {
+	return &enumeration{ctx: ctx, name: name, comment: comment}
+}
+
+type enumeration struct {
+	ctx     generators.Context
+	name    string
+	comment *ast.CommentGroup
+	d       definition
+	column  string
+}
+
+func (t *enumeration) Generate(dst io.Writer) (err error) {
+	var (
+		columns []genieql.ColumnInfo
+	)
+
+	if t.d == nil {
+		return errorsx.String("missing definition, unable to generate enum. please call the From method")
+	}
+
+	t.ctx.Println("generation of", t.name, "initiated")
+	defer t.ctx.Println("generation of", t.name, "completed")
+
+	if columns, err = t.d.Columns(); err != nil {
+		return err
+	}
+
+	idx := slices.IndexFunc(columns, func(c genieql.ColumnInfo) bool { return c.Name == t.column })
+	if idx < 0 {
+		return errorsx.Errorf("genieql.Enum %s - unable to locate column %s", t.name, t.column)
+	}
+
+	if len(columns[idx].Definition.Enum) == 0 {
+		return errorsx.Errorf("genieql.Enum %s - column %s is not an enumerated type", t.name, t.column)
+	}
+
+	return generators.NewEnum(t.name, t.comment, columns[idx].Definition.Enum...).Generate(dst)
+}
+
+func (t *enumeration) From(d definition, column string) Enum {
+	t.d = d
+	t.column = column
+	return t
+}
+
+func (t enumeration) Table(s string) definition {
+	return Table(t.ctx.Driver, t.ctx.Dialect, s)
+}
+
+func (t enumeration) Query(s string) definition {
+	return Query(t.ctx.Driver, t.ctx.Dialect, s)
+}
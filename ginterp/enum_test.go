@@ -0,0 +1,57 @@
+package ginterp_test
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/genieqltest"
+	. "github.com/james-lawrence/genieql/ginterp"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/membufx"
+	"github.com/james-lawrence/genieql/internal/testx"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Enum", func() {
+	config := DialectConfig1()
+	ctx, err := genieqltest.GeneratorContext(config)
+	errorsx.MaybePanic(err)
+
+	DescribeTable(
+		"examples",
+		func(in Enum, out io.Reader) {
+			var (
+				b         = bytes.NewBufferString("package example\n")
+				formatted = bytes.NewBufferString("")
+			)
+
+			Expect(in.Generate(b)).To(Succeed())
+			Expect(astcodec.FormatOutput(formatted, b.Bytes())).To(Succeed())
+			Expect(formatted.String()).To(Equal(testx.IOString(out)))
+		},
+		Entry(
+			"example 1 - enumerated column",
+			func() Enum {
+				e := NewEnum(ctx, "Mood", nil)
+				e.From(e.Table("struct_enum"), "mood")
+				return e
+			}(),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/enums/example.1.go"))),
+		),
+	)
+
+	It("should fail when the column is not an enumerated type", func() {
+		e := NewEnum(ctx, "Mood", nil)
+		e.From(e.Table("struct_enum"), "a")
+		Expect(e.Generate(io.Discard)).To(MatchError(ContainSubstring("column a is not an enumerated type")))
+	})
+
+	It("should fail when the column does not exist", func() {
+		e := NewEnum(ctx, "Mood", nil)
+		e.From(e.Table("struct_enum"), "missing")
+		Expect(e.Generate(io.Discard)).To(MatchError(ContainSubstring("unable to locate column missing")))
+	})
+})
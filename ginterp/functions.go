@@ -1,11 +1,17 @@
 package ginterp
 
 import (
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/printer"
+	"go/token"
+	"go/types"
 	"io"
 	"log"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/james-lawrence/genieql"
 	"github.com/james-lawrence/genieql/astcodec"
@@ -16,9 +22,20 @@ import (
 )
 
 // Function configuration interface for generating functions.
+// slice parameters can be expanded by the query. i.e.) WHERE id IN ({ids...})
+// dialects binding arrays encode the slice using the array type of the driver.
+// optional clauses are dropped when their parameters are nil pointers or empty slices.
+// i.e.) WHERE 't' {? AND status = {status}}
+// functions returning (sql.Result, error) or (int64, error) execute the query
+// returning the result or the rows affected respectively.
+// parameters declared as any are replaced by the type the database expects for their placeholder,
+// when validation is enabled the declared types are checked against the expected types.
 type Function interface {
 	genieql.Generator // must satisfy the generator interface
 	Query(string) Function
+	// QueryFile read the query from a file relative to the package at generation time.
+	// i.e.) gql.QueryFile("queries/find_user.sql")
+	QueryFile(string) Function
 }
 
 // NewFunction instantiate a new function generator. it uses the name of function
@@ -41,7 +58,6 @@ func FunctionFromFile(cctx generators.Context, name string, tree *ast.File) (Fun
 	var (
 		ok          bool
 		pos         *ast.FuncDecl
-		scanner     *ast.FuncDecl // scanner to use for the results.
 		declPattern *ast.FuncType
 	)
 
@@ -55,16 +71,20 @@ func FunctionFromFile(cctx generators.Context, name string, tree *ast.File) (Fun
 		return nil, errorsx.String("genieql.Function second parameter must be a function type")
 	}
 
-	if scanner = functions.DetectScanner(cctx, declPattern); scanner == nil {
+	// exec functions do not require a scanner.
+	if !functions.DetectExec(declPattern) && functions.DetectScanner(cctx, declPattern) == nil {
 		return nil, errorsx.Errorf("genieql.Function %s - missing scanner", nodeInfo(cctx, pos))
 	}
 
-	return NewFunction(
+	fn := NewFunction(
 		cctx,
 		name,
 		declPattern,
 		pos.Doc,
-	), nil
+	).(*function)
+	fn.position = cctx.FileSet.PositionFor(pos.Pos(), true)
+
+	return fn, nil
 }
 
 type function struct {
@@ -73,6 +93,8 @@ type function struct {
 	signature *ast.FuncType
 	comment   *ast.CommentGroup
 	query     string
+	queryfile string
+	position  token.Position // location of the declaration, used to report invalid queries.
 }
 
 func (t *function) Query(q string) Function {
@@ -80,6 +102,11 @@ func (t *function) Query(q string) Function {
 	return t
 }
 
+func (t *function) QueryFile(path string) Function {
+	t.queryfile = path
+	return t
+}
+
 func (t *function) Generate(dst io.Writer) (err error) {
 	var (
 		n            *ast.FuncDecl
@@ -91,11 +118,21 @@ func (t *function) Generate(dst io.Writer) (err error) {
 		locals       []ast.Spec
 		transforms   []ast.Stmt
 		encodedquery string
+		arrays       = true
+		query        = t.query
+		mapped       []*ast.Field
+		runtime      []ast.Stmt
 	)
 
 	t.ctx.Println("generation of", t.name, "initiated")
 	defer t.ctx.Println("generation of", t.name, "completed")
 
+	if t.queryfile != "" {
+		if query, err = readQueryFile(t.ctx, t.queryfile); err != nil {
+			return errorsx.Wrapf(err, "genieql.Function %s - unable to read query file %s", t.name, t.queryfile)
+		}
+	}
+
 	if cf = functions.DetectContext(t.signature); cf != nil {
 		// pop the context off the params.
 		t.signature.Params.List = t.signature.Params.List[1:]
@@ -109,14 +146,103 @@ func (t *function) Generate(dst io.Writer) (err error) {
 	qf = t.signature.Params.List[0]
 	t.signature.Params.List = generators.NormalizeFieldNames(t.signature.Params.List[1:]...)
 
-	scanner := functions.DetectScanner(t.ctx, t.signature)
+	var (
+		scanner    *ast.FuncDecl
+		errHandler func(string) ast.Node
+		exec       = functions.DetectExec(t.signature)
+	)
+
+	if exec {
+		errHandler = functions.ExecErrorHandling(t.signature.Results)
+	} else {
+		scanner = functions.DetectScanner(t.ctx, t.signature)
+	}
+
+	mapped = t.signature.Params.List
+	params := astutil.FlattenFields(t.signature.Params.List...)
+	expanded := functions.ExpandedParams(query)
+	if len(expanded) > 0 {
+		for _, name := range expanded {
+			idx := slices.IndexFunc(params, func(f *ast.Field) bool { return f.Names[0].Name == name })
+			if idx < 0 {
+				return errorsx.Errorf("genieql.Function %s - expanded parameter %s does not exist", t.name, name)
+			}
+
+			if typ, ok := params[idx].Type.(*ast.ArrayType); !ok || typ.Len != nil {
+				return errorsx.Errorf("genieql.Function %s - expanded parameter %s must be a slice", t.name, name)
+			}
+		}
+
+		// when the dialect is unable to bind arrays the values are expanded at runtime.
+		if query, arrays = functions.ExpandArrays(t.ctx.Dialect, query, expanded...); !arrays {
+			mapped = slices.DeleteFunc(slices.Clone(params), func(f *ast.Field) bool { return slices.Contains(expanded, f.Names[0].Name) })
+		}
+	}
+
+	// queries built at runtime declare locals that collide with parameters of the same name.
+	if !arrays || functions.HasOptional(query) {
+		query = sanitizeRuntimeQuery(query, params...)
+		t.signature.Params.List = generators.SanitizeFieldIdents(sanitizeRuntimeIdent, t.signature.Params.List...)
+		mapped = generators.SanitizeFieldIdents(sanitizeRuntimeIdent, mapped...)
+		params = astutil.FlattenFields(t.signature.Params.List...)
+		for idx, name := range expanded {
+			expanded[idx] = sanitizeRuntimeIdent(ast.NewIdent(name)).Name
+		}
+	}
+
+	if slices.ContainsFunc(params, inferredParam) {
+		// the placeholders of queries built at runtime are unknown until execution.
+		if !arrays || functions.HasOptional(query) {
+			return errorsx.Errorf("%s: genieql.Function %s - unable to infer parameter types of queries built at runtime", t.position, t.name)
+		}
+
+		if t.signature.Params.List, err = t.inferParams(query, t.signature.Params.List...); err != nil {
+			return errorsx.Wrapf(err, "%s: genieql.Function %s", t.position, t.name)
+		}
+
+		mapped = t.signature.Params.List
+		params = astutil.FlattenFields(t.signature.Params.List...)
+	}
 
-	if cmaps, err = generators.ColumnMapFromFields(t.ctx, t.signature.Params.List...); err != nil {
+	if cmaps, err = generators.ColumnMapFromFields(t.ctx, mapped...); err != nil {
 		return errorsx.Wrap(err, "unable to generate mapping")
 	}
 
-	encodedquery, cmaps = functions.ColumnUsageFilter(t.ctx, t.query, cmaps...)
-	if locals, encodings, qinputs, err = generators.QueryInputsFromColumnMap(t.ctx, scanner, nil, cmaps...); err != nil {
+	// slices bound as arrays are encoded using the array type of the driver. i.e.) pgtype.Int8Array
+	if arrays {
+		for _, name := range expanded {
+			if idx := slices.IndexFunc(cmaps, func(c genieql.ColumnMap) bool { return c.Name == name }); idx >= 0 && cmaps[idx].Definition.Encode != "" {
+				continue
+			}
+
+			typ := params[slices.IndexFunc(params, func(f *ast.Field) bool { return f.Names[0].Name == name })].Type
+			return errorsx.Errorf("genieql.Function %s - unable to bind expanded parameter %s as an array, the driver has no array type for %s", t.name, name, types.ExprString(typ))
+		}
+	}
+
+	encodedquery, cmaps = functions.ColumnUsageFilter(t.ctx, query, cmaps...)
+	// avoid dereferencing the nil pointers of optional clauses, the clause is dropped when they're nil.
+	// pointers used by required clauses are always dereferenced.
+	optional := functions.OptionalParams(query)
+	guard := func(c genieql.ColumnMap) ast.Expr {
+		name, _, _ := strings.Cut(types.ExprString(astutil.DereferencedIdent(c.Dst)), ".")
+		if !slices.Contains(optional, name) {
+			return nil
+		}
+
+		idx := slices.IndexFunc(params, func(f *ast.Field) bool { return f.Names[0].Name == name })
+		if idx < 0 {
+			return nil
+		}
+
+		if _, ok := params[idx].Type.(*ast.StarExpr); !ok {
+			return nil
+		}
+
+		return astutil.BinaryExpr(ast.NewIdent(name), token.NEQ, ast.NewIdent("nil"))
+	}
+
+	if locals, encodings, qinputs, err = generators.GuardedQueryInputsFromColumnMap(t.ctx, scanner, errHandler, guard, cmaps...); err != nil {
 		return errorsx.Wrap(err, "unable to transform query inputs")
 	}
 
@@ -142,7 +268,48 @@ func (t *function) Generate(dst io.Writer) (err error) {
 		QueryInputs:  qinputs,
 	}
 
-	if n, err = qfn.Compile(functions.New(t.name, t.signature)); err != nil {
+	// the query is built at runtime when the values are expanded or clauses are optional.
+	if !arrays || functions.HasOptional(query) {
+		if errHandler == nil {
+			errHandler = generators.ScannerErrorHandling(scanner)
+		}
+
+		if runtime, err = functions.ExpandRuntime(t.ctx, errHandler, query, params, cmaps, qinputs); err != nil {
+			return errorsx.Wrapf(err, "genieql.Function %s", t.name)
+		}
+
+		qfn.Query = nil
+		qfn.Transforms = append(transforms, runtime...)
+		qfn.QueryInputs = []ast.Expr{ast.NewIdent("args")}
+		qfn.Variadic = true
+	}
+
+	// queries built at runtime are not known until execution and are unable to be validated.
+	if t.ctx.Configuration.Validate && qfn.Query != nil {
+		if err = t.ctx.Dialect.Validate(encodedquery); err != nil {
+			return errorsx.Wrapf(err, "%s: genieql.Function %s - invalid query", t.position, t.name)
+		}
+
+		if err = t.checkParams(query, cmaps...); err != nil {
+			return errorsx.Wrapf(err, "%s: genieql.Function %s - mismatched parameter types", t.position, t.name)
+		}
+	}
+
+	if exec {
+		n, err = functions.Exec{
+			Context:      t.ctx,
+			Query:        qfn.Query,
+			Queryer:      qfn.Queryer,
+			ContextField: qfn.ContextField,
+			Transforms:   qfn.Transforms,
+			QueryInputs:  qfn.QueryInputs,
+			Variadic:     qfn.Variadic,
+		}.Compile(functions.New(t.name, t.signature))
+	} else {
+		n, err = qfn.Compile(functions.New(t.name, t.signature))
+	}
+
+	if err != nil {
 		return err
 	}
 
@@ -156,3 +323,135 @@ func (t *function) Generate(dst io.Writer) (err error) {
 
 	return nil
 }
+
+// sanitizeRuntimeIdent prevents the parameters from colliding with the locals
+// of queries built at runtime.
+func sanitizeRuntimeIdent(i *ast.Ident) *ast.Ident {
+	switch i.Name {
+	case "q", "query", "args", "idx", "v":
+		// normalized to match the parameters of the generated function.
+		return generators.NormalizeIdent(ast.NewIdent("_genieql_" + i.Name))[0]
+	}
+
+	return i
+}
+
+// sanitizeRuntimeQuery renames the parameters referenced by the query to match
+// the sanitized parameters. i.e.) {args...} becomes {genieqlArgs...}
+func sanitizeRuntimeQuery(q string, params ...*ast.Field) string {
+	for _, p := range params {
+		for _, name := range p.Names {
+			renamed := sanitizeRuntimeIdent(name)
+			if renamed.Name == name.Name {
+				continue
+			}
+
+			ref := regexp.MustCompile(`\{` + regexp.QuoteMeta(name.Name) + `([.}])`)
+			q = ref.ReplaceAllString(q, "{"+renamed.Name+"${1}")
+		}
+	}
+
+	return q
+}
+
+// inferredParam parameters declared as any have their type inferred from the query.
+func inferredParam(f *ast.Field) bool {
+	switch types.ExprString(f.Type) {
+	case "any", "interface{}":
+		return true
+	default:
+		return false
+	}
+}
+
+// inferParams replaces the type of the inferred parameters with the type the database
+// expects for their placeholders.
+func (t *function) inferParams(query string, fields ...*ast.Field) (_ []*ast.Field, err error) {
+	var (
+		cmaps  []genieql.ColumnMap
+		pinfo  []genieql.ColumnInfo
+		result = make([]*ast.Field, 0, len(fields))
+	)
+
+	for _, f := range fields {
+		if !inferredParam(f) {
+			result = append(result, f)
+			continue
+		}
+
+		// each parameter is able to be inferred as a different type.
+		result = append(result, astutil.FlattenFields(f)...)
+	}
+
+	for _, f := range result {
+		var mapped []genieql.ColumnMap
+
+		if !inferredParam(f) {
+			if mapped, err = generators.ColumnMapFromFields(t.ctx, f); err != nil {
+				return nil, errorsx.Wrap(err, "unable to generate mapping")
+			}
+
+			cmaps = append(cmaps, mapped...)
+			continue
+		}
+
+		cmaps = append(cmaps, genieql.ColumnMap{
+			ColumnInfo: genieql.ColumnInfo{Name: f.Names[0].Name},
+			Dst:        f.Names[0],
+			Field:      f,
+		})
+	}
+
+	encoded, used := functions.ColumnUsageFilter(t.ctx, query, cmaps...)
+	if pinfo, err = t.ctx.Dialect.ParameterInformationForQuery(t.ctx.Driver, encoded); err != nil {
+		return nil, errorsx.Wrap(err, "unable to determine parameter types")
+	}
+
+	for _, f := range result {
+		if !inferredParam(f) {
+			continue
+		}
+
+		idx := slices.IndexFunc(used, func(c genieql.ColumnMap) bool { return c.Field == f })
+		if idx < 0 {
+			return nil, errorsx.Errorf("unable to infer the type of parameter %s, it is not used by the query", f.Names[0].Name)
+		}
+
+		if idx >= len(pinfo) || pinfo[idx].Definition.Native == "" {
+			return nil, errorsx.Errorf("unable to infer the type of parameter %s, the database did not report a type for its placeholder", f.Names[0].Name)
+		}
+
+		f.Type = astutil.Expr(pinfo[idx].Definition.Native)
+	}
+
+	return result, nil
+}
+
+// checkParams compares the declared types of the parameters against the types the
+// database expects for their placeholders. placeholders of unknown type are skipped.
+func (t *function) checkParams(query string, cmaps ...genieql.ColumnMap) (err error) {
+	var (
+		pinfo      []genieql.ColumnInfo
+		mismatched []error
+	)
+
+	// the placeholders are numbered in the order the columns are used by the query.
+	encoded, used := functions.ColumnUsageFilter(t.ctx, query, cmaps...)
+	if pinfo, err = t.ctx.Dialect.ParameterInformationForQuery(t.ctx.Driver, encoded); err != nil {
+		return errorsx.Wrap(err, "unable to determine parameter types")
+	}
+
+	for idx, c := range used[:min(len(used), len(pinfo))] {
+		expected := pinfo[idx].Definition
+		if expected.Native == "" || c.Definition.Native == expected.Native {
+			continue
+		}
+
+		mismatched = append(mismatched, errorsx.Errorf(
+			"parameter %s declared as %s, placeholder %s expects %s (%s)",
+			types.ExprString(c.Dst), c.Definition.Native, pinfo[idx].Name, expected.Native, expected.Type,
+		))
+	}
+
+	return errors.Join(mismatched...)
+}
@@ -1,10 +1,20 @@
 package ginterp_test
 
 import (
-	// . "github.com/james-lawrence/genieql/ginterp"
+	"bytes"
+	"go/ast"
+	"io"
+
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/genieqltest"
+	. "github.com/james-lawrence/genieql/ginterp"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/membufx"
+	"github.com/james-lawrence/genieql/internal/testx"
 
 	. "github.com/onsi/ginkgo/v2"
-	// . "github.com/onsi/gomega"
+	. "github.com/onsi/gomega"
 )
 
 // TODO need to properly wire up the genieql configureation to resolve structA.
@@ -51,4 +61,308 @@ var _ = Describe("Functions", func() {
 	// 		io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/functions/example.1.go"))),
 	// 	),
 	// )
+
+	runtime, err := genieqltest.GeneratorContext(DialectConfig2())
+	errorsx.MaybePanic(err)
+	arrays, err := genieqltest.GeneratorContext(DialectConfig8())
+	errorsx.MaybePanic(err)
+	unencoded, err := genieqltest.GeneratorContext(DialectConfig3())
+	errorsx.MaybePanic(err)
+	validated, err := genieqltest.GeneratorContext(DialectConfig5())
+	errorsx.MaybePanic(err)
+	typed, err := genieqltest.GeneratorContext(DialectConfig6())
+	errorsx.MaybePanic(err)
+
+	declared := func(typ string) *ast.FuncType {
+		return astutil.FuncType(
+			astutil.FieldList(
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(astutil.Expr(typ), ast.NewIdent("b")),
+				astutil.Field(ast.NewIdent("int"), ast.NewIdent("limit")),
+			),
+			astutil.FieldList(
+				astutil.Field(ast.NewIdent("StaticExampleScanner")),
+			),
+		)
+	}
+
+	signature := func() *ast.FuncType {
+		return astutil.FuncType(
+			astutil.FieldList(
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(astutil.Expr("[]int"), ast.NewIdent("ids")),
+				astutil.Field(ast.NewIdent("int"), ast.NewIdent("limit")),
+			),
+			astutil.FieldList(
+				astutil.Field(ast.NewIdent("StaticExampleScanner")),
+			),
+		)
+	}
+
+	optional := func() *ast.FuncType {
+		return astutil.FuncType(
+			astutil.FieldList(
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(astutil.Expr("*bool"), ast.NewIdent("b")),
+				astutil.Field(astutil.Expr("[]int"), ast.NewIdent("ids")),
+				astutil.Field(ast.NewIdent("int"), ast.NewIdent("limit")),
+			),
+			astutil.FieldList(
+				astutil.Field(ast.NewIdent("StaticExampleScanner")),
+			),
+		)
+	}
+
+	DescribeTable(
+		"expanded parameters",
+		func(in Function, out io.Reader) {
+			var (
+				b         = bytes.NewBufferString("package example\n")
+				formatted = bytes.NewBufferString("")
+			)
+
+			Expect(in.Generate(b)).To(Succeed())
+			Expect(astcodec.FormatOutput(formatted, b.Bytes())).To(Succeed())
+			Expect(formatted.String()).To(Equal(testx.IOString(out)))
+		},
+		Entry(
+			"example 2 - expand the values at runtime",
+			NewFunction(
+				runtime,
+				"FunctionExample2",
+				signature(),
+				nil,
+			).Query("SELECT a FROM struct_a WHERE a IN ({ids...}) LIMIT {limit}"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/functions/example.2.go"))),
+		),
+		Entry(
+			"example 3 - bind the values as an array",
+			NewFunction(
+				arrays,
+				"FunctionExample3",
+				signature(),
+				nil,
+			).Query("SELECT a FROM struct_a WHERE a IN ({ids...}) LIMIT {limit}"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/functions/example.3.go"))),
+		),
+		Entry(
+			"example 5 - optional clauses built at runtime",
+			NewFunction(
+				runtime,
+				"FunctionExample5",
+				optional(),
+				nil,
+			).Query("SELECT a FROM struct_a WHERE 't' {? AND b = {b}} {? AND a IN ({ids...})} LIMIT {limit}"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/functions/example.5.go"))),
+		),
+		Entry(
+			"example 6 - optional clauses with arrays",
+			NewFunction(
+				arrays,
+				"FunctionExample6",
+				optional(),
+				nil,
+			).Query("SELECT a FROM struct_a WHERE 't' {? AND b = {b}} {? AND a IN ({ids...})} LIMIT {limit}"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/functions/example.6.go"))),
+		),
+		Entry(
+			"example 8 - execute returning the result",
+			NewFunction(
+				arrays,
+				"FunctionExample8",
+				astutil.FuncType(
+					astutil.FieldList(
+						astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+						astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+						astutil.Field(astutil.Expr("[]int"), ast.NewIdent("ids")),
+					),
+					astutil.FieldList(
+						astutil.Field(astutil.Expr("sql.Result")),
+						astutil.Field(ast.NewIdent("error")),
+					),
+				),
+				nil,
+			).Query("DELETE FROM struct_a WHERE a IN ({ids...})"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/functions/example.8.go"))),
+		),
+		Entry(
+			"example 9 - execute returning the rows affected",
+			NewFunction(
+				runtime,
+				"FunctionExample9",
+				astutil.FuncType(
+					astutil.FieldList(
+						astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+						astutil.Field(astutil.Expr("*bool"), ast.NewIdent("b")),
+						astutil.Field(ast.NewIdent("int"), ast.NewIdent("limit")),
+					),
+					astutil.FieldList(
+						astutil.Field(ast.NewIdent("int64")),
+						astutil.Field(ast.NewIdent("error")),
+					),
+				),
+				nil,
+			).Query("UPDATE struct_a SET b = {b} WHERE a < {limit}"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/functions/example.9.go"))),
+		),
+		Entry(
+			"example 10 - query from a file",
+			NewFunction(
+				arrays,
+				"FunctionExample10",
+				signature(),
+				nil,
+			).QueryFile("queries/example.10.sql"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/functions/example.10.go"))),
+		),
+		Entry(
+			"example 14 - infer the parameter types from the placeholders",
+			NewFunction(
+				typed,
+				"FunctionExample14",
+				declared("any"),
+				nil,
+			).Query("SELECT a FROM struct_a WHERE b = {b} LIMIT {limit}"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/functions/example.14.go"))),
+		),
+		Entry(
+			"example 17 - execute with the values expanded at runtime",
+			NewFunction(
+				runtime,
+				"FunctionExample17",
+				astutil.FuncType(
+					astutil.FieldList(
+						astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+						astutil.Field(astutil.Expr("[]bool"), ast.NewIdent("flags")),
+					),
+					astutil.FieldList(
+						astutil.Field(ast.NewIdent("int64")),
+						astutil.Field(ast.NewIdent("error")),
+					),
+				),
+				nil,
+			).Query("DELETE FROM struct_a WHERE b IN ({flags...})"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/functions/example.17.go"))),
+		),
+		Entry(
+			"example 18 - parameters named after the locals of queries built at runtime",
+			NewFunction(
+				runtime,
+				"FunctionExample18",
+				astutil.FuncType(
+					astutil.FieldList(
+						astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+						astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+						astutil.Field(astutil.Expr("*bool"), ast.NewIdent("v")),
+						astutil.Field(astutil.Expr("[]int"), ast.NewIdent("args")),
+						astutil.Field(ast.NewIdent("int"), ast.NewIdent("idx")),
+					),
+					astutil.FieldList(
+						astutil.Field(ast.NewIdent("StaticExampleScanner")),
+					),
+				),
+				nil,
+			).Query("SELECT a FROM struct_a WHERE a IN ({args...}) {? AND b = {v}} LIMIT {idx}"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/functions/example.18.go"))),
+		),
+	)
+
+	It("should report missing query files", func() {
+		gen := NewFunction(
+			arrays,
+			"FunctionExample11",
+			signature(),
+			nil,
+		).QueryFile("queries/missing.sql")
+		Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("unable to read query file queries/missing.sql")))
+	})
+
+	It("should require optional parameters to be pointers or slices", func() {
+		gen := NewFunction(
+			runtime,
+			"FunctionExample7",
+			optional(),
+			nil,
+		).Query("SELECT a FROM struct_a {? LIMIT {limit}}")
+		Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("optional parameter limit must be a pointer or a slice")))
+	})
+
+	It("should report queries rejected by the database", func() {
+		gen := NewFunction(
+			validated,
+			"FunctionExample12",
+			signature(),
+			nil,
+		).Query("SELECT a FROM struct_b WHERE a IN ({ids...}) LIMIT {limit}")
+		Expect(gen.Generate(io.Discard)).To(MatchError(And(
+			ContainSubstring("genieql.Function FunctionExample12 - invalid query"),
+			ContainSubstring("relation \"struct_b\" does not exist"),
+		)))
+	})
+
+	It("should accept queries validated by the database", func() {
+		gen := NewFunction(
+			validated,
+			"FunctionExample13",
+			signature(),
+			nil,
+		).Query("SELECT a FROM struct_a WHERE a IN ({ids...}) LIMIT {limit}")
+		Expect(gen.Generate(io.Discard)).To(Succeed())
+	})
+
+	It("should report parameters mismatching the placeholder types", func() {
+		gen := NewFunction(
+			typed,
+			"FunctionExample15",
+			declared("string"),
+			nil,
+		).Query("SELECT a FROM struct_a WHERE b = {b} LIMIT {limit}")
+		Expect(gen.Generate(io.Discard)).To(MatchError(And(
+			ContainSubstring("genieql.Function FunctionExample15 - mismatched parameter types"),
+			ContainSubstring("parameter b declared as string, placeholder $1 expects int (int)"),
+		)))
+	})
+
+	It("should match the placeholder types to the parameters used by the query", func() {
+		gen := NewFunction(
+			typed,
+			"FunctionExample15",
+			declared("string"),
+			nil,
+		).Query("SELECT a FROM struct_a LIMIT {limit}")
+		Expect(gen.Generate(io.Discard)).To(Succeed())
+	})
+
+	It("should require inferred parameters to be used by the query", func() {
+		gen := NewFunction(
+			typed,
+			"FunctionExample16",
+			declared("any"),
+			nil,
+		).Query("SELECT a FROM struct_a LIMIT {limit}")
+		Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("unable to infer the type of parameter b, it is not used by the query")))
+	})
+
+	It("should require an array type for slices bound as arrays", func() {
+		gen := NewFunction(
+			unencoded,
+			"FunctionExample19",
+			signature(),
+			nil,
+		).Query("SELECT a FROM struct_a WHERE a IN ({ids...}) LIMIT {limit}")
+		Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("unable to bind expanded parameter ids as an array, the driver has no array type for []int")))
+	})
+
+	It("should require expanded parameters to be slices", func() {
+		gen := NewFunction(
+			runtime,
+			"FunctionExample4",
+			signature(),
+			nil,
+		).Query("SELECT a FROM struct_a WHERE a IN ({limit...})")
+		Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("expanded parameter limit must be a slice")))
+	})
 })
@@ -3,14 +3,22 @@ package ginterp
 import (
 	"fmt"
 	"go/ast"
+	"strings"
+
+	"github.com/serenize/snaker"
 
 	"github.com/james-lawrence/genieql"
 	// register the drivers
 
 	"github.com/james-lawrence/genieql/generators"
 	_ "github.com/james-lawrence/genieql/internal/drivers"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	// register the mysql dialect
+	_ "github.com/james-lawrence/genieql/internal/mysql"
 	// register the postgresql dialect
 	_ "github.com/james-lawrence/genieql/internal/postgresql"
+	// register the sqlite3 dialect
+	_ "github.com/james-lawrence/genieql/internal/sqlite3"
 	// register the wasi dialect
 	_ "github.com/james-lawrence/genieql/internal/wasidialect"
 )
@@ -63,23 +71,32 @@ func (t TableInfo) Columns() ([]genieql.ColumnInfo, error) {
 	return t.Dialect.ColumnInformationForTable(t.Driver, t.Name)
 }
 
-// Camelcase the column name.
+// Camelcase the column name. unlike the default field naming initialisms
+// are not treated specially. i.e.) uuid_field becomes UuidField not UUIDField.
 func Camelcase(c genieql.ColumnInfo) genieql.ColumnInfo {
+	words := strings.FieldsFunc(c.Name, func(r rune) bool { return r == '_' })
+	for i, w := range words {
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+	}
+	c.Name = strings.Join(words, "")
 	return c
 }
 
-// Snakecase the column name.
+// Snakecase the column name. the result is unexported, use it before Camelcase or Uppercase.
 func Snakecase(c genieql.ColumnInfo) genieql.ColumnInfo {
+	c.Name = snaker.CamelToSnake(c.Name)
 	return c
 }
 
-// Lowercase the column name.
+// Lowercase the column name. the result is unexported, use it before Camelcase or Uppercase.
 func Lowercase(c genieql.ColumnInfo) genieql.ColumnInfo {
+	c.Name = strings.ToLower(c.Name)
 	return c
 }
 
 // Uppercase the column name.
 func Uppercase(c genieql.ColumnInfo) genieql.ColumnInfo {
+	c.Name = strings.ToUpper(c.Name)
 	return c
 }
 
@@ -92,3 +109,13 @@ func nodeInfo(ctx generators.Context, n ast.Node) string {
 		return fmt.Sprintf("(%s.%T - %s)", ctx.CurrentPackage.Name, n, pos)
 	}
 }
+
+// returning ensures the dialect returns the rows modified by a statement, the generated
+// functions read the modified rows using the scanner.
+func returning(ctx generators.Context, gen string, name string) error {
+	if ctx.Dialect.Returning() {
+		return nil
+	}
+
+	return errorsx.Errorf("%s %s - the dialect is unable to return the modified rows, i.e.) RETURNING, use genieql.Function with an explicit query", gen, name)
+}
@@ -3,9 +3,14 @@ package ginterp
 import (
 	"fmt"
 	"go/ast"
+	"go/parser"
 	"go/token"
 	"go/types"
 	"io"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/james-lawrence/genieql"
@@ -25,6 +30,13 @@ type InsertBatch interface {
 	Default(...string) InsertBatch // use the database default for the specified columns.
 	Conflict(string) InsertBatch   // specify how conflicts should be handled.
 	Batch(n int) InsertBatch       // specify a batch insert
+	// chunk the records by the maximum number of parameters the dialect supports, ignores Batch.
+	Unbounded() InsertBatch
+	// insert every chunk within a single transaction. the queryer must implement BeginTx,
+	// the transaction is committed by Close once every record is inserted.
+	Transaction() InsertBatch
+	// specify the columns that conflict and how to resolve them.
+	OnConflict(...string) Upsert[InsertBatch]
 }
 
 // NewInsert instantiate a new insert generator. it uses the name of function
@@ -83,17 +95,20 @@ func InsertBatchFromFile(cctx generators.Context, name string, tree *ast.File) (
 }
 
 type batch struct {
-	ctx      generators.Context
-	n        int // number of records to support inserting
-	name     string
-	table    string
-	conflict string
-	defaults []string
-	tf       *ast.Field    // type field.
-	cf       *ast.Field    // context field, can be nil.
-	qf       *ast.Field    // db Query field.
-	scanner  *ast.FuncDecl // scanner being used for results.
-	comment  *ast.CommentGroup
+	ctx       generators.Context
+	n         int  // number of records to support inserting
+	unbounded bool // chunk records by the parameter limit of the dialect.
+	tx        bool // insert the records within a transaction.
+	name      string
+	table     string
+	conflict  string
+	upsert    *conflict
+	defaults  []string
+	tf        *ast.Field    // type field.
+	cf        *ast.Field    // context field, can be nil.
+	qf        *ast.Field    // db Query field.
+	scanner   *ast.FuncDecl // scanner being used for results.
+	comment   *ast.CommentGroup
 }
 
 // Into specify the table the data will be inserted into.
@@ -111,15 +126,35 @@ func (t *batch) Default(defaults ...string) InsertBatch {
 // Conflict specify how to handle conflict during an insert.
 func (t *batch) Conflict(s string) InsertBatch {
 	t.conflict = s
+	t.upsert = nil
 	return t
 }
 
+// OnConflict specify the columns that conflict, the returned value
+// determines how the conflict is resolved.
+func (t *batch) OnConflict(columns ...string) Upsert[InsertBatch] {
+	t.upsert = &conflict{target: columns}
+	return upsert[InsertBatch]{parent: t, dst: t.upsert}
+}
+
 // Batch specify the maximum number of records to insert.
 func (t *batch) Batch(size int) InsertBatch {
 	t.n = size
 	return t
 }
 
+// Unbounded chunk the records by the maximum number of parameters the dialect supports.
+func (t *batch) Unbounded() InsertBatch {
+	t.unbounded = true
+	return t
+}
+
+// Transaction insert all the chunks within a single transaction.
+func (t *batch) Transaction() InsertBatch {
+	t.tx = true
+	return t
+}
+
 func (t *batch) Generate(dst io.Writer) (err error) {
 	var (
 		cmaps       []genieql.ColumnMap
@@ -134,13 +169,23 @@ func (t *batch) Generate(dst io.Writer) (err error) {
 	t.ctx.Debugln("batch.insert type", t.tf.Names[0])
 	t.ctx.Debugln("batch.insert scanner", t.scanner)
 
+	if t.tx && !beginsTx(t.ctx, t.qf.Type) {
+		return errorsx.Errorf("genieql.InsertBatch %s - Transaction requires a queryer with a BeginTx method; i.e.) *sql.DB, found %s", t.name, types.ExprString(t.qf.Type))
+	}
+
+	if err = returning(t.ctx, "genieql.InsertBatch", t.name); err != nil {
+		return err
+	}
+
 	if cmaps, err = generators.ColumnMapFromFields(t.ctx, t.tf); err != nil {
 		return errorsx.Wrap(err, "unable to generate mapping")
 	}
 
-	defaulted := genieql.ColumnInfoFilterIgnore(t.defaults...)
-
 	cset := genieql.ColumnMapSet(cmaps)
+	// generated columns are computed by the database, as such they're always defaulted.
+	defaults := append(slices.Clone(t.defaults), cset.ColumnInfo().Generated().ColumnNames()...)
+	defaulted := genieql.ColumnInfoFilterIgnore(defaults...)
+
 	defaultedcset := cset.Filter(func(cm genieql.ColumnMap) bool { return defaulted(cm.ColumnInfo) })
 
 	queryfields = generators.QueryFieldsFromColumnMap(t.ctx, defaultedcset.Map(func(idx int, cm genieql.ColumnMap) genieql.ColumnMap {
@@ -202,16 +247,22 @@ func (t *batch) Generate(dst io.Writer) (err error) {
 		),
 	)
 
+	typefields := []*ast.Field{
+		t.cf,
+		t.qf,
+		astutil.Field(
+			&ast.ArrayType{Elt: t.tf.Type}, ast.NewIdent("remaining")),
+		astutil.Field(t.scanner.Type.Results.List[0].Type, ast.NewIdent("scanner")),
+	}
+
+	if t.tx {
+		typefields = append(typefields, astutil.Field(astutil.Expr("*sql.Tx"), ast.NewIdent("tx")))
+	}
+
 	typedecl := typespec.NewType(typename, &ast.StructType{
 		Struct: token.Pos(0),
 		Fields: &ast.FieldList{
-			List: []*ast.Field{
-				t.cf,
-				t.qf,
-				astutil.Field(
-					&ast.ArrayType{Elt: t.tf.Type}, ast.NewIdent("remaining")),
-				astutil.Field(t.scanner.Type.Results.List[0].Type, ast.NewIdent("scanner")),
-			},
+			List: typefields,
 		},
 	})
 
@@ -307,6 +358,97 @@ func (t *batch) Generate(dst io.Writer) (err error) {
 		),
 	)
 
+	// within a transaction close finalizes the transaction, committing only when every
+	// record was inserted without error.
+	if t.tx {
+		closefn = functions.NewFn(
+			astutil.DeclStmt(
+				astutil.VarList(
+					astutil.ValueSpec(ast.NewIdent("error"), ast.NewIdent("err")),
+				),
+			),
+			astutil.If(
+				nil,
+				astutil.BinaryExpr(astutil.SelExpr("t", "scanner"), token.NEQ, ast.NewIdent("nil")),
+				astutil.Block(
+					astutil.Assign(
+						astutil.ExprList(ast.NewIdent("err")),
+						token.ASSIGN,
+						astutil.ExprList(
+							astutil.CallExpr(
+								astutil.SelExpr("errors", "Join"),
+								astutil.CallExpr(&ast.SelectorExpr{X: astutil.SelExpr("t", "scanner"), Sel: ast.NewIdent("Err")}),
+								astutil.CallExpr(&ast.SelectorExpr{X: astutil.SelExpr("t", "scanner"), Sel: ast.NewIdent("Close")}),
+							),
+						),
+					),
+				),
+				nil,
+			),
+			astutil.If(
+				nil,
+				astutil.BinaryExpr(astutil.SelExpr("t", "tx"), token.EQL, ast.NewIdent("nil")),
+				astutil.Block(
+					astutil.Return(ast.NewIdent("err")),
+				),
+				nil,
+			),
+			astutil.Assign(
+				astutil.ExprList(ast.NewIdent("tx")),
+				token.DEFINE,
+				astutil.ExprList(astutil.SelExpr("t", "tx")),
+			),
+			astutil.Assign(
+				astutil.ExprList(astutil.SelExpr("t", "tx")),
+				token.ASSIGN,
+				astutil.ExprList(ast.NewIdent("nil")),
+			),
+			astutil.If(
+				nil,
+				astutil.BinaryExpr(
+					astutil.BinaryExpr(ast.NewIdent("err"), token.NEQ, ast.NewIdent("nil")),
+					token.LOR,
+					astutil.BinaryExpr(astutil.CallExpr(ast.NewIdent("len"), astutil.SelExpr("t", "remaining")), token.GTR, astutil.IntegerLiteral(0)),
+				),
+				astutil.Block(
+					astutil.Return(
+						astutil.CallExpr(
+							astutil.SelExpr("errors", "Join"),
+							ast.NewIdent("err"),
+							astutil.CallExpr(astutil.SelExpr("tx", "Rollback")),
+						),
+					),
+				),
+				nil,
+			),
+			astutil.Return(astutil.CallExpr(astutil.SelExpr("tx", "Commit"))),
+		)
+	}
+
+	// release the scanner of the previous chunk before advancing.
+	var release ast.Expr = astutil.BinaryExpr(
+		astutil.CallExpr(
+			astutil.SelExpr("t", "Close"),
+		),
+		token.EQL,
+		ast.NewIdent("nil"),
+	)
+
+	// within a transaction Close finalizes the transaction, only close the scanner.
+	if t.tx {
+		release = &ast.ParenExpr{
+			X: astutil.BinaryExpr(
+				astutil.BinaryExpr(astutil.SelExpr("t", "scanner"), token.EQL, ast.NewIdent("nil")),
+				token.LOR,
+				astutil.BinaryExpr(
+					astutil.CallExpr(&ast.SelectorExpr{X: astutil.SelExpr("t", "scanner"), Sel: ast.NewIdent("Close")}),
+					token.EQL,
+					ast.NewIdent("nil"),
+				),
+			),
+		}
+	}
+
 	nextsig := &ast.FuncType{
 		Params: &ast.FieldList{},
 		Results: &ast.FieldList{
@@ -342,13 +484,7 @@ func (t *batch) Generate(dst io.Writer) (err error) {
 			nil, astutil.BinaryExpr(
 				astutil.BinaryExpr(astutil.CallExpr(ast.NewIdent("len"), astutil.SelExpr("t", "remaining")), token.GTR, astutil.IntegerLiteral(0)),
 				token.LAND,
-				astutil.BinaryExpr(
-					astutil.CallExpr(
-						astutil.SelExpr("t", "Close"),
-					),
-					token.EQL,
-					ast.NewIdent("nil"),
-				),
+				release,
 			),
 			astutil.Block(
 				astutil.Assign(
@@ -424,9 +560,25 @@ func (t *batch) Generate(dst io.Writer) (err error) {
 		return errorsx.Wrap(err, "failed to generate encoding function")
 	}
 
-	valueTupleExprs := make([]ast.Expr, t.n)
+	n := t.n
+	if t.unbounded {
+		n = 1
+	}
+
+	conflicts := t.conflict
+	if t.upsert != nil {
+		if conflicts, err = t.upsert.clause(t.ctx, t.table, defaultedcset.ColumnNames()); err != nil {
+			return errorsx.Wrapf(err, "genieql.InsertBatch %s", t.name)
+		}
+	}
+
+	// dialects unable to insert multiple rows of defaults generate a single row regardless of the records.
+	// i.e.) INSERT INTO t DEFAULT VALUES
+	if single := t.ctx.Dialect.Insert(1, 0, t.table, conflicts, cset.ColumnNames(), cset.ColumnNames(), defaults); (t.n > 1 || t.unbounded) && single == t.ctx.Dialect.Insert(2, 0, t.table, conflicts, cset.ColumnNames(), cset.ColumnNames(), defaults) {
+		return errorsx.Errorf("genieql.InsertBatch %s - unable to insert multiple records into %s, every column is defaulted: %s", t.name, t.table, single)
+	}
 
-	qi := functions.QueryLiteralColumnMapReplacer(t.ctx, t.ctx.Dialect.Insert(t.n, 0, t.table, t.conflict, cset.ColumnNames(), cset.ColumnNames(), t.defaults), cmaps...)
+	qi := functions.QueryLiteralColumnMapReplacer(t.ctx, t.ctx.Dialect.Insert(n, 0, t.table, conflicts, cset.ColumnNames(), cset.ColumnNames(), defaults), cmaps...)
 	queryPrefix, remaining, _ := strings.Cut(qi, "VALUES")
 	queryPrefix += "VALUES "
 	querySuffix := ""
@@ -441,15 +593,12 @@ func (t *batch) Generate(dst io.Writer) (err error) {
 
 	tuples = strings.ReplaceAll(tuples, "),(", ")),((")
 	tuplesarr := strings.Split(tuples, "),(")
-	for i := range t.n {
-		valueTupleExprs[i] = astutil.StringLiteral(strings.TrimSpace(tuplesarr[i]))
-	}
 
 	colIdents := astutil.MapFieldsToNameExpr(queryfields...)
 	transformLHS := append(astutil.MapFieldsToNameExpr(queryfields...), ast.NewIdent("err"))
 	appendCallArgs := append([]ast.Expr{ast.NewIdent("args")}, colIdents...)
 
-	loopbody := astutil.Block(
+	loopstmts := []ast.Stmt{
 		astutil.Assign(
 			transformLHS,
 			token.DEFINE,
@@ -480,9 +629,9 @@ func (t *batch) Generate(dst io.Writer) (err error) {
 			token.ASSIGN,
 			astutil.ExprList(astutil.CallExpr(ast.NewIdent("append"), appendCallArgs...)),
 		),
-	)
+	}
 
-	advancefn := functions.NewFn(
+	advancestmts := []ast.Stmt{
 		astutil.Assign(
 			astutil.ExprList(ast.NewIdent("transform")),
 			token.DEFINE,
@@ -504,33 +653,27 @@ func (t *batch) Generate(dst io.Writer) (err error) {
 			),
 			nil,
 		),
-		astutil.Assign(
-			astutil.ExprList(ast.NewIdent("n")),
-			token.DEFINE,
-			astutil.ExprList(
-				astutil.CallExpr(
-					ast.NewIdent("min"),
-					astutil.CallExpr(ast.NewIdent("len"), t.tf.Names[0]),
-					astutil.IntegerLiteral(t.n),
+	}
+
+	argsdecl := astutil.Assign(
+		astutil.ExprList(ast.NewIdent("args")),
+		token.DEFINE,
+		astutil.ExprList(
+			astutil.CallExpr(
+				ast.NewIdent("make"),
+				&ast.ArrayType{Elt: ast.NewIdent("any")},
+				astutil.IntegerLiteral(0),
+				astutil.BinaryExpr(
+					ast.NewIdent("n"),
+					token.MUL,
+					astutil.IntegerLiteral(len(queryfields)),
 				),
 			),
 		),
-		astutil.DeclStmt(genieql.QueryLiteral("queryPrefix", queryPrefix)),
-		astutil.DeclStmt(genieql.QueryLiteral("querySuffix", querySuffix)),
-		astutil.Assign(
-			astutil.ExprList(ast.NewIdent("valueTuples")),
-			token.DEFINE,
-			astutil.ExprList(
-				&ast.CompositeLit{
-					Type: &ast.ArrayType{
-						Len: astutil.IntegerLiteral(t.n),
-						Elt: ast.NewIdent("string"),
-					},
-					Elts: valueTupleExprs,
-				},
-			),
-		),
-		astutil.Assign(
+	)
+
+	querydecl := func(values ast.Expr) ast.Stmt {
+		return astutil.Assign(
 			astutil.ExprList(ast.NewIdent("query")),
 			token.DEFINE,
 			astutil.ExprList(
@@ -540,7 +683,7 @@ func (t *batch) Generate(dst io.Writer) (err error) {
 						token.ADD,
 						astutil.CallExpr(
 							&ast.SelectorExpr{X: ast.NewIdent("strings"), Sel: ast.NewIdent("Join")},
-							&ast.SliceExpr{X: ast.NewIdent("valueTuples"), High: ast.NewIdent("n")},
+							values,
 							astutil.StringLiteral(","),
 						),
 					),
@@ -548,36 +691,148 @@ func (t *batch) Generate(dst io.Writer) (err error) {
 					ast.NewIdent("querySuffix"),
 				),
 			),
-		),
-		astutil.Assign(
-			astutil.ExprList(ast.NewIdent("args")),
-			token.DEFINE,
-			astutil.ExprList(
-				astutil.CallExpr(
-					ast.NewIdent("make"),
-					&ast.ArrayType{Elt: ast.NewIdent("any")},
-					astutil.IntegerLiteral(0),
-					astutil.BinaryExpr(
+		)
+	}
+
+	if t.unbounded {
+		// the number of records is bounded by the parameters a single statement can bind.
+		var batchsize ast.Expr = astutil.CallExpr(ast.NewIdent("len"), t.tf.Names[0])
+		if limit := t.ctx.Dialect.MaxParameters(); limit > 0 {
+			batchsize = astutil.CallExpr(
+				ast.NewIdent("min"),
+				batchsize,
+				astutil.IntegerLiteral(max(limit/max(len(queryfields), 1), 1)),
+			)
+		}
+
+		tuple, tupleargs := batchTupleFormat(strings.TrimSpace(tuplesarr[0]), len(queryfields))
+		var valuetuple ast.Expr = ast.NewIdent("valueTuple")
+		if len(tupleargs) > 0 {
+			valuetuple = astutil.CallExpr(astutil.SelExpr("fmt", "Sprintf"), append([]ast.Expr{valuetuple}, tupleargs...)...)
+		}
+
+		loopstmts = append([]ast.Stmt{
+			astutil.Assign(
+				astutil.ExprList(ast.NewIdent("valueTuples")),
+				token.ASSIGN,
+				astutil.ExprList(astutil.CallExpr(ast.NewIdent("append"), ast.NewIdent("valueTuples"), valuetuple)),
+			),
+		}, loopstmts...)
+
+		advancestmts = append(
+			advancestmts,
+			astutil.Assign(astutil.ExprList(ast.NewIdent("n")), token.DEFINE, astutil.ExprList(batchsize)),
+			astutil.DeclStmt(genieql.QueryLiteral("queryPrefix", queryPrefix)),
+			astutil.DeclStmt(genieql.QueryLiteral("querySuffix", querySuffix)),
+			astutil.DeclStmt(genieql.QueryLiteral("valueTuple", tuple)),
+			astutil.Assign(
+				astutil.ExprList(ast.NewIdent("valueTuples")),
+				token.DEFINE,
+				astutil.ExprList(
+					astutil.CallExpr(
+						ast.NewIdent("make"),
+						&ast.ArrayType{Elt: ast.NewIdent("string")},
+						astutil.IntegerLiteral(0),
 						ast.NewIdent("n"),
-						token.MUL,
-						astutil.IntegerLiteral(len(queryfields)),
 					),
 				),
 			),
-		),
-		astutil.Range(
-			ast.NewIdent("i"),
-			nil,
-			token.DEFINE,
-			ast.NewIdent("n"),
-			loopbody,
-		),
+			argsdecl,
+			astutil.Range(ast.NewIdent("i"), nil, token.DEFINE, ast.NewIdent("n"), astutil.Block(loopstmts...)),
+			querydecl(ast.NewIdent("valueTuples")),
+		)
+	} else {
+		valueTupleExprs := make([]ast.Expr, t.n)
+		for i := range t.n {
+			valueTupleExprs[i] = astutil.StringLiteral(strings.TrimSpace(tuplesarr[i]))
+		}
+
+		advancestmts = append(
+			advancestmts,
+			astutil.Assign(
+				astutil.ExprList(ast.NewIdent("n")),
+				token.DEFINE,
+				astutil.ExprList(
+					astutil.CallExpr(
+						ast.NewIdent("min"),
+						astutil.CallExpr(ast.NewIdent("len"), t.tf.Names[0]),
+						astutil.IntegerLiteral(t.n),
+					),
+				),
+			),
+			astutil.DeclStmt(genieql.QueryLiteral("queryPrefix", queryPrefix)),
+			astutil.DeclStmt(genieql.QueryLiteral("querySuffix", querySuffix)),
+			astutil.Assign(
+				astutil.ExprList(ast.NewIdent("valueTuples")),
+				token.DEFINE,
+				astutil.ExprList(
+					&ast.CompositeLit{
+						Type: &ast.ArrayType{
+							Len: astutil.IntegerLiteral(t.n),
+							Elt: ast.NewIdent("string"),
+						},
+						Elts: valueTupleExprs,
+					},
+				),
+			),
+			querydecl(&ast.SliceExpr{X: ast.NewIdent("valueTuples"), High: ast.NewIdent("n")}),
+			argsdecl,
+			astutil.Range(ast.NewIdent("i"), nil, token.DEFINE, ast.NewIdent("n"), astutil.Block(loopstmts...)),
+		)
+	}
+
+	queryer := astutil.SelExpr("t", "q")
+	if t.tx {
+		queryer = astutil.SelExpr("t", "tx")
+
+		// the transaction begins with the first chunk.
+		advancestmts = append(
+			advancestmts,
+			astutil.If(
+				nil,
+				astutil.BinaryExpr(astutil.SelExpr("t", "tx"), token.EQL, ast.NewIdent("nil")),
+				astutil.Block(
+					astutil.DeclStmt(
+						astutil.VarList(
+							astutil.ValueSpec(ast.NewIdent("error"), ast.NewIdent("err")),
+						),
+					),
+					astutil.If(
+						astutil.Assign(
+							astutil.ExprList(astutil.SelExpr("t", "tx"), ast.NewIdent("err")),
+							token.ASSIGN,
+							astutil.ExprList(
+								astutil.CallExpr(
+									&ast.SelectorExpr{X: astutil.SelExpr("t", "q"), Sel: ast.NewIdent("BeginTx")},
+									astutil.SelExpr("t", "ctx"),
+									ast.NewIdent("nil"),
+								),
+							),
+						),
+						astutil.BinaryExpr(ast.NewIdent("err"), token.NEQ, ast.NewIdent("nil")),
+						astutil.Block(
+							astutil.Return(
+								errhandling("err"),
+								astutil.CallExpr(&ast.ArrayType{Elt: t.tf.Type}, ast.NewIdent("nil")),
+								ast.NewIdent("false"),
+							),
+						),
+						nil,
+					),
+				),
+				nil,
+			),
+		)
+	}
+
+	advancestmts = append(
+		advancestmts,
 		astutil.Return(
 			astutil.CallExpr(
 				t.scanner.Name,
 				astutil.CallExprEllipsis(
 					&ast.SelectorExpr{
-						X:   astutil.SelExpr("t", "q"),
+						X:   queryer,
 						Sel: ast.NewIdent("QueryContext"),
 					},
 					ast.NewIdent("t.ctx"),
@@ -593,6 +848,8 @@ func (t *batch) Generate(dst io.Writer) (err error) {
 		),
 	)
 
+	advancefn := functions.NewFn(advancestmts...)
+
 	return genieql.NewFuncGenerator(func(dst io.Writer) (err error) {
 		if err = generators.GenerateComment(generators.DefaultFunctionComment(t.name), t.comment).Generate(dst); err != nil {
 			return err
@@ -653,3 +910,100 @@ func (t *batch) Generate(dst io.Writer) (err error) {
 		return nil
 	}).Generate(dst)
 }
+
+// batchTupleFormat converts the numbered placeholders of a value tuple into a format
+// string, returning the expressions computing the placeholders of the ith record.
+// i.e.) ($1,$2) becomes ($%d,$%d) with the arguments i*2 + 1, i*2 + 2.
+func batchTupleFormat(tuple string, columns int) (string, []ast.Expr) {
+	var (
+		args []ast.Expr
+	)
+
+	for _, m := range batchPlaceholder.FindAllStringSubmatch(tuple, -1) {
+		offset, _ := strconv.Atoi(m[1])
+		args = append(args, astutil.BinaryExpr(
+			astutil.BinaryExpr(ast.NewIdent("i"), token.MUL, astutil.IntegerLiteral(columns)),
+			token.ADD,
+			astutil.IntegerLiteral(offset),
+		))
+	}
+
+	if len(args) == 0 {
+		return tuple, nil
+	}
+
+	return batchPlaceholder.ReplaceAllLiteralString(strings.ReplaceAll(tuple, "%", "%%"), "$%d"), args
+}
+
+// matches numbered placeholders. i.e.) $1
+var batchPlaceholder = regexp.MustCompile(`\$(\d+)`)
+
+// types known to provide BeginTx(context.Context, *sql.TxOptions) (*sql.Tx, error).
+var beginTxTypes = []string{"*sql.DB", "*sql.Conn"}
+
+// beginsTx determines if the queryer type provides a BeginTx method. interfaces
+// and types declared within the current package are inspected directly.
+func beginsTx(ctx generators.Context, x ast.Expr) bool {
+	if slices.Contains(beginTxTypes, types.ExprString(x)) {
+		return true
+	}
+
+	switch x := x.(type) {
+	case *ast.InterfaceType:
+		return slices.ContainsFunc(x.Methods.List, func(m *ast.Field) bool {
+			return slices.ContainsFunc(m.Names, func(n *ast.Ident) bool { return n.Name == "BeginTx" })
+		})
+	case *ast.StarExpr:
+		if ident, ok := x.X.(*ast.Ident); ok {
+			return beginsTxLocal(ctx, ident.Name)
+		}
+	case *ast.Ident:
+		return beginsTxLocal(ctx, x.Name)
+	}
+
+	return false
+}
+
+// beginsTxLocal searches the current package for the named type and its BeginTx method.
+func beginsTxLocal(ctx generators.Context, name string) bool {
+	if ctx.CurrentPackage == nil {
+		return false
+	}
+
+	for _, f := range ctx.CurrentPackage.GoFiles {
+		src, err := parser.ParseFile(token.NewFileSet(), filepath.Join(ctx.CurrentPackage.Dir, f), nil, parser.SkipObjectResolution)
+		if err != nil {
+			ctx.Debugln("unable to parse", f, err)
+			continue
+		}
+
+		for _, decl := range src.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok && ts.Name.Name == name && beginsTx(ctx, ts.Type) {
+						return true
+					}
+				}
+			case *ast.FuncDecl:
+				if d.Recv == nil || d.Name.Name != "BeginTx" || len(d.Recv.List) == 0 {
+					continue
+				}
+
+				if astcodec.Ident(removeStar(d.Recv.List[0].Type)) == name {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func removeStar(x ast.Expr) ast.Expr {
+	if s, ok := x.(*ast.StarExpr); ok {
+		return s.X
+	}
+
+	return x
+}
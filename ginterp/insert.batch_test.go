@@ -28,6 +28,10 @@ var _ = Describe("Batch Insert", func() {
 	config := DialectConfig1()
 	ctx, err := genieqltest.GeneratorContext(config)
 	errorsx.MaybePanic(err)
+	limited, err := genieqltest.GeneratorContext(DialectConfig4())
+	errorsx.MaybePanic(err)
+	defaulted, err := genieqltest.GeneratorContext(DialectConfig9())
+	errorsx.MaybePanic(err)
 
 	DescribeTable(
 		"examples",
@@ -98,5 +102,111 @@ var _ = Describe("Batch Insert", func() {
 			).Into("foo").Conflict("ON CONFLICT id = {s.A}").Batch(2),
 			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/insert.batch/example.4.go"))),
 		),
+		Entry(
+			"example 5 - batch insert structured upsert",
+			NewBatchInsert(
+				ctx,
+				"BatchInsertExample1",
+				nil,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("s")),
+				rowsScanner,
+			).Into("struct_a").OnConflict("a").DoUpdate("c", "d").Batch(2),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/insert.batch/example.5.go"))),
+		),
+		Entry(
+			"example 6 - unbounded batch insert limited by the dialect parameters",
+			NewBatchInsert(
+				limited,
+				"BatchInsertExample1",
+				nil,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				rowsScanner,
+			).Into("foo").Default("b").Unbounded(),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/insert.batch/example.6.go"))),
+		),
+		Entry(
+			"example 7 - unbounded batch insert within a transaction",
+			NewBatchInsert(
+				ctx,
+				"BatchInsertExample1",
+				nil,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("*sql.DB"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				rowsScanner,
+			).Into("foo").Unbounded().Transaction(),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/insert.batch/example.7.go"))),
+		),
+	)
+
+	It("should reject transactions when the queryer lacks BeginTx", func() {
+		gen := NewBatchInsert(
+			ctx,
+			"BatchInsertExample1",
+			nil,
+			astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+			astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+			astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+			rowsScanner,
+		).Into("foo").Unbounded().Transaction()
+		Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("Transaction requires a queryer with a BeginTx method; i.e.) *sql.DB, found sqlx.Queryer")))
+	})
+
+	It("should support transactions with an interface providing BeginTx", func() {
+		gen := NewBatchInsert(
+			ctx,
+			"BatchInsertExample1",
+			nil,
+			astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+			astutil.Field(astutil.Expr("interface{ sqlx.Queryer; BeginTx(context.Context, *sql.TxOptions) (*sql.Tx, error) }"), ast.NewIdent("q")),
+			astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+			rowsScanner,
+		).Into("foo").Unbounded().Transaction()
+		Expect(gen.Generate(io.Discard)).To(Succeed())
+	})
+
+	It("should require a dialect returning the inserted rows", func() {
+		noreturning, err := genieqltest.GeneratorContext(DialectConfig7())
+		Expect(err).To(Succeed())
+
+		gen := NewBatchInsert(
+			noreturning,
+			"BatchInsertExample1",
+			nil,
+			astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+			astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+			astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+			rowsScanner,
+		).Into("foo").Batch(2)
+		Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("the dialect is unable to return the modified rows")))
+	})
+
+	DescribeTable(
+		"should reject batches when every column is defaulted",
+		func(gen InsertBatch) {
+			Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("unable to insert multiple records into foo, every column is defaulted")))
+		},
+		Entry("bounded", NewBatchInsert(
+			defaulted,
+			"BatchInsertExample1",
+			nil,
+			astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+			astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+			astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+			rowsScanner,
+		).Into("foo").Batch(2)),
+		Entry("unbounded", NewBatchInsert(
+			defaulted,
+			"BatchInsertExample1",
+			nil,
+			astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+			astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+			astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+			rowsScanner,
+		).Into("foo").Unbounded()),
 	)
 })
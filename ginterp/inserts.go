@@ -3,8 +3,10 @@ package ginterp
 import (
 	"fmt"
 	"go/ast"
+	"go/token"
 	"go/types"
 	"io"
+	"slices"
 
 	"github.com/james-lawrence/genieql"
 	"github.com/james-lawrence/genieql/astcodec"
@@ -21,6 +23,8 @@ type Insert interface {
 	Ignore(...string) Insert  // do not attempt to insert the specified column.
 	Default(...string) Insert // use the database default for the specified columns.
 	Conflict(string) Insert   // specify how conflicts should be handled.
+	// specify the columns that conflict and how to resolve them.
+	OnConflict(...string) Upsert[Insert]
 }
 
 func InsertFromFile(cctx generators.Context, name string, tree *ast.File) (Insert, error) {
@@ -91,6 +95,11 @@ func NewInsert(
 	tf *ast.Field,
 	params ...*ast.Field,
 ) Insert {
+	if !ctx.Dialect.Returning() {
+		cf, qf, tf = sanitizeReselectField(cf), sanitizeReselectField(qf), sanitizeReselectField(tf)
+		params = generators.SanitizeFieldIdents(sanitizeReselectIdent, params...)
+	}
+
 	return &insert{
 		ctx:     ctx,
 		name:    name,
@@ -108,6 +117,7 @@ type insert struct {
 	name     string
 	table    string
 	conflict string
+	upsert   *conflict
 	defaults []string
 	ignore   []string
 	params   []*ast.Field
@@ -141,9 +151,17 @@ func (t *insert) Ignore(ignore ...string) Insert {
 
 func (t *insert) Conflict(s string) Insert {
 	t.conflict = s
+	t.upsert = nil
 	return t
 }
 
+// OnConflict specify the columns that conflict, the returned value
+// determines how the conflict is resolved.
+func (t *insert) OnConflict(columns ...string) Upsert[Insert] {
+	t.upsert = &conflict{target: columns}
+	return upsert[Insert]{parent: t, dst: t.upsert}
+}
+
 func (t *insert) Generate(dst io.Writer) (err error) {
 	var (
 		insertcmaps []genieql.ColumnMap
@@ -169,8 +187,10 @@ func (t *insert) Generate(dst io.Writer) (err error) {
 		return errorsx.Wrap(err, "unable to generate mapping")
 	}
 
+	// generated columns are computed by the database, as such they're always defaulted.
+	defaults := append(slices.Clone(t.defaults), genieql.ColumnMapSet(insertcmaps).ColumnInfo().Generated().ColumnNames()...)
 	ignored := genieql.ColumnInfoFilterIgnore(t.ignore...)
-	defaulted := genieql.ColumnInfoFilterIgnore(t.defaults...)
+	defaulted := genieql.ColumnInfoFilterIgnore(defaults...)
 
 	cset0 := genieql.ColumnMapSet(paramscmaps)
 	ignoredcset0 := cset0.Filter(func(cm genieql.ColumnMap) bool { return ignored(cm.ColumnInfo) })
@@ -191,10 +211,17 @@ func (t *insert) Generate(dst io.Writer) (err error) {
 	ignoredcset := cset.Filter(func(cm genieql.ColumnMap) bool { return ignored(cm.ColumnInfo) })
 	projectioncset := ignoredcset.Filter(func(cm genieql.ColumnMap) bool { return defaulted(cm.ColumnInfo) })
 
+	conflicts := t.conflict
+	if t.upsert != nil {
+		if conflicts, err = t.upsert.clause(t.ctx, t.table, projectioncset.ColumnNames()); err != nil {
+			return errorsx.Wrapf(err, "genieql.Insert %s", t.name)
+		}
+	}
+
 	g1 := generators.NewColumnConstants(
 		fmt.Sprintf("%sStaticColumns", t.name),
 		genieql.ColumnValueTransformer{
-			Defaults:           append(t.defaults, t.ignore...),
+			Defaults:           append(defaults, t.ignore...),
 			DialectTransformer: dialect.ColumnValueTransformer(),
 		},
 		cset.ColumnInfo(),
@@ -221,16 +248,22 @@ func (t *insert) Generate(dst io.Writer) (err error) {
 					1,
 					len(paramscmaps)-len(insertcmaps),
 					t.table,
-					t.conflict,
+					conflicts,
 					cset.ColumnNames(),
 					ignoredcset.ColumnNames(),
-					append(t.defaults, t.ignore...),
+					append(defaults, t.ignore...),
 				),
 				projectioncset0...,
 			),
 		),
 	}
 
+	if !dialect.Returning() {
+		if qfn, err = t.reselect(qfn, ignoredcset, projectioncset, qinputs[len(qinputs)-len(projectioncset):]); err != nil {
+			return errorsx.Wrapf(err, "genieql.Insert %s", t.name)
+		}
+	}
+
 	sig := &ast.FuncType{
 		Params: &ast.FieldList{
 			List: astutil.FlattenFields(t.params...),
@@ -253,3 +286,104 @@ func (t *insert) Generate(dst io.Writer) (err error) {
 		}),
 	).Generate(dst)
 }
+
+// sanitizeReselectField prevents the parameters from colliding with the
+// local variables used to select the inserted row.
+func sanitizeReselectField(f *ast.Field) *ast.Field {
+	if f == nil {
+		return nil
+	}
+
+	return generators.SanitizeFieldIdents(sanitizeReselectIdent, f)[0]
+}
+
+func sanitizeReselectIdent(i *ast.Ident) *ast.Ident {
+	switch i.Name {
+	case "id", "result", "insertquery", "query", "err":
+		// normalized to match the parameters of the generated function.
+		return generators.NormalizeIdent(ast.NewIdent("_genieql_" + i.Name))[0]
+	}
+
+	return i
+}
+
+// reselect rewrites the query for dialects unable to return the inserted row. the insert
+// is executed and the row is selected by its primary key, a key column defaulted by the
+// database is selected using the id the database generated for the insert.
+// inputs are the query inputs of the inserted columns.
+func (t *insert) reselect(qfn functions.Query, projection, inserted genieql.ColumnMapSet, inputs []ast.Expr) (_ functions.Query, err error) {
+	var (
+		details   genieql.TableDetails
+		keys      []ast.Expr
+		generated int
+		id        = ast.NewIdent("id")
+		result    = ast.NewIdent("result")
+		ctx       = astutil.Expr("context.Background()")
+		onerr     = generators.ScannerErrorHandling(t.scanner)
+	)
+
+	if details, err = genieql.LookupTableDetails(t.ctx.Driver, t.ctx.Dialect, t.table); err != nil {
+		return qfn, err
+	}
+
+	pkey := genieql.ColumnInfoSet(details.Columns).PrimaryKey().ColumnNames()
+	if len(pkey) == 0 {
+		return qfn, errorsx.Errorf("table %s requires a primary key to select the inserted row", t.table)
+	}
+
+	for _, column := range pkey {
+		if idx := slices.Index(inserted.ColumnNames(), column); idx >= 0 {
+			keys = append(keys, inputs[idx])
+			continue
+		}
+
+		generated++
+		keys = append(keys, id)
+	}
+
+	if generated > 1 {
+		return qfn, errorsx.Errorf("table %s has %d primary key columns without values, the database only generates an id for one", t.table, generated)
+	}
+
+	if t.cf != nil {
+		ctx = astutil.MapFieldsToNameExpr(t.cf)[0]
+	}
+
+	exec := astutil.CallExpr(
+		astutil.SelExpr("q", "ExecContext"),
+		append([]ast.Expr{ctx, ast.NewIdent("insertquery")}, qfn.QueryInputs...)...,
+	)
+	failed := astutil.BinaryExpr(ast.NewIdent("err"), token.NEQ, ast.NewIdent("nil"))
+
+	transforms := []ast.Stmt{
+		astutil.ConstDecl("insertquery", qfn.Query),
+		astutil.ConstDecl("query", astutil.StringLiteral(t.ctx.Dialect.Select(t.table, projection.ColumnNames(), pkey))),
+	}
+	transforms = append(transforms, qfn.Transforms...)
+
+	if generated == 0 {
+		transforms = append(
+			transforms,
+			astutil.If(
+				astutil.Assign(astutil.ExprList(ast.NewIdent("_"), ast.NewIdent("err")), token.DEFINE, astutil.ExprList(exec)),
+				failed,
+				astutil.Block(onerr("err").(ast.Stmt)),
+				nil,
+			),
+		)
+	} else {
+		transforms = append(
+			transforms,
+			astutil.Assign(astutil.ExprList(result, ast.NewIdent("err")), token.DEFINE, astutil.ExprList(exec)),
+			astutil.If(nil, failed, astutil.Block(onerr("err").(ast.Stmt)), nil),
+			astutil.Assign(astutil.ExprList(id, ast.NewIdent("err")), token.DEFINE, astutil.ExprList(astutil.CallExpr(astutil.SelExpr(result.Name, "LastInsertId")))),
+			astutil.If(nil, failed, astutil.Block(onerr("err").(ast.Stmt)), nil),
+		)
+	}
+
+	qfn.Query = nil
+	qfn.Transforms = transforms
+	qfn.QueryInputs = keys
+
+	return qfn, nil
+}
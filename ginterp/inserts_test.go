@@ -130,5 +130,179 @@ var _ = Describe("Insert", func() {
 			).Into("foo").Ignore("a").Default("b").Conflict("ON CONFLICT id = {id} AND c = {a.C}"),
 			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/inserts/example.6.go"))),
 		),
+		Entry(
+			"example 7 - structured upsert ignoring conflicts",
+			NewInsert(
+				ctx,
+				"InsertExample7",
+				nil,
+				rowsScanner,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+			).Into("struct_a").Default("b").OnConflict("a").DoNothing(),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/inserts/example.7.go"))),
+		),
+		Entry(
+			"example 8 - structured upsert updating all but the specified columns",
+			NewInsert(
+				ctx,
+				"InsertExample8",
+				nil,
+				rowsScanner,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+			).Into("struct_a").Default("b").OnConflict("a").DoUpdateExcept("c"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/inserts/example.8.go"))),
+		),
+		Entry(
+			"example 9 - generated columns are defaulted",
+			NewInsert(
+				ctx,
+				"InsertExample9",
+				nil,
+				rowsScanner,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructD"), ast.NewIdent("a")),
+				astutil.Field(ast.NewIdent("StructD"), ast.NewIdent("a")),
+			).Into("struct_d"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/inserts/example.9.go"))),
+		),
 	)
+
+	Describe("dialects unable to return the inserted rows", func() {
+		ctx, err := genieqltest.GeneratorContext(DialectConfig7())
+		errorsx.MaybePanic(err)
+
+		DescribeTable(
+			"examples",
+			func(in Insert, out io.Reader) {
+				var (
+					b         = bytes.NewBufferString("package example\n")
+					formatted = bytes.NewBufferString("")
+				)
+
+				Expect(in.Generate(b)).To(Succeed())
+				Expect(astcodec.FormatOutput(formatted, b.Bytes())).To(Succeed())
+				Expect(formatted.String()).To(Equal(testx.IOString(out)))
+			},
+			Entry(
+				"example 1 - select the inserted row by the provided key",
+				NewInsert(
+					ctx,
+					"InsertExample11",
+					nil,
+					rowsScanner,
+					astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+					astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+					astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+					astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				).Into("struct_a_pk"),
+				io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/inserts/example.11.go"))),
+			),
+			Entry(
+				"example 2 - select the inserted row by the generated id",
+				NewInsert(
+					ctx,
+					"InsertExample12",
+					nil,
+					rowsScanner,
+					nil,
+					astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+					astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+					astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				).Into("struct_a_pk").Default("a"),
+				io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/inserts/example.12.go"))),
+			),
+			Entry(
+				"example 3 - parameters named after the local variables",
+				NewInsert(
+					ctx,
+					"InsertExample10",
+					nil,
+					rowsScanner,
+					astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+					astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+					astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("result")),
+					astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("result")),
+				).Into("struct_a_pk").Default("a"),
+				io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/inserts/example.10.go"))),
+			),
+		)
+
+		DescribeTable(
+			"errors",
+			func(in Insert, cause string) {
+				Expect(in.Generate(io.Discard)).To(MatchError(ContainSubstring(cause)))
+			},
+			Entry(
+				"tables without a primary key",
+				NewInsert(
+					ctx,
+					"InsertExample13",
+					nil,
+					rowsScanner,
+					astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+					astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+					astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+					astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				).Into("struct_a"),
+				"table struct_a requires a primary key to select the inserted row",
+			),
+			Entry(
+				"multiple generated key columns",
+				NewInsert(
+					ctx,
+					"InsertExample14",
+					nil,
+					rowsScanner,
+					astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+					astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+					astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+					astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				).Into("struct_a_pk").Default("a").Ignore("b"),
+				"table struct_a_pk has 2 primary key columns without values",
+			),
+		)
+	})
+
+	It("should encode through overridden types", func() {
+		var (
+			b         = bytes.NewBufferString("package example\n")
+			formatted = bytes.NewBufferString("")
+		)
+
+		gen := NewInsert(
+			ctx,
+			"InsertExample10",
+			nil,
+			rowsScanner,
+			astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+			astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+			astutil.Field(ast.NewIdent("StructC"), ast.NewIdent("a")),
+			astutil.Field(ast.NewIdent("StructC"), ast.NewIdent("a")),
+		).Into("struct_c")
+
+		Expect(gen.Generate(b)).To(Succeed())
+		Expect(astcodec.FormatOutput(formatted, b.Bytes())).To(Succeed())
+		Expect(formatted.String()).To(ContainSubstring("c1.String = string(a.Status)"))
+	})
+
+	It("should reject conflict columns missing from the table", func() {
+		gen := NewInsert(
+			ctx,
+			"InsertExample9",
+			nil,
+			rowsScanner,
+			astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+			astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+			astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+			astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+		).Into("struct_a").OnConflict("missing").DoNothing()
+		Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("conflict column missing does not exist in table struct_a")))
+	})
 })
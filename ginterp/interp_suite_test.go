@@ -1,7 +1,10 @@
 package ginterp_test
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"strings"
 	"testing"
 
 	"github.com/james-lawrence/genieql"
@@ -25,6 +28,9 @@ func DialectConfig1() genieql.Configuration {
 		Quote:             "\"",
 		CValueTransformer: columninfo.NewNameTransformer(),
 		QueryInsert:       "INSERT INTO :gql.insert.tablename: (:gql.insert.columns:) VALUES :gql.insert.values::gql.insert.conflict: RETURNING :gql.insert.returning:",
+		QuerySelect:       "SELECT :gql.select.columns: FROM :gql.select.tablename: WHERE :gql.select.predicates:",
+		QueryUpdate:       "UPDATE :gql.update.tablename: SET :gql.update.columns: WHERE :gql.update.predicates: RETURNING :gql.update.returning:",
+		QueryDelete:       "DELETE FROM :gql.delete.tablename: WHERE :gql.delete.predicates: RETURNING :gql.delete.returning:",
 	}))
 	if err != nil {
 		log.Println("failed to register test dialect", dialect, err)
@@ -35,3 +41,166 @@ func DialectConfig1() genieql.Configuration {
 		Driver:   drivers.StandardLib,
 	}
 }
+
+// DialectConfig4 dialect limiting the number of parameters a statement binds.
+func DialectConfig4() genieql.Configuration {
+	const dialect = "test.dialect.4"
+	err := dialects.Register(dialect, dialects.TestFactory(dialects.Test{
+		Quote:             "\"",
+		CValueTransformer: columninfo.NewNameTransformer(),
+		Parameters:        100,
+		QueryInsert:       "INSERT INTO :gql.insert.tablename: (:gql.insert.columns:) VALUES :gql.insert.values::gql.insert.conflict: RETURNING :gql.insert.returning:",
+	}))
+	if err != nil {
+		log.Println("failed to register test dialect", dialect, err)
+	}
+	return genieql.Configuration{
+		Location: ".fixtures/.genieql",
+		Dialect:  dialect,
+		Driver:   drivers.StandardLib,
+	}
+}
+
+// DialectConfig7 dialect unable to return the inserted rows.
+func DialectConfig7() genieql.Configuration {
+	const dialect = "test.dialect.7"
+	err := dialects.Register(dialect, dialects.TestFactory(dialects.Test{
+		Quote:        "\"",
+		LastInsertID: true,
+		QueryInsert:  "INSERT INTO :gql.insert.tablename: (:gql.insert.columns:) VALUES :gql.insert.values::gql.insert.conflict:",
+		QuerySelect:  "SELECT :gql.select.columns: FROM :gql.select.tablename: WHERE :gql.select.predicates:",
+	}))
+	if err != nil {
+		log.Println("failed to register test dialect", dialect, err)
+	}
+	return genieql.Configuration{
+		Location: ".fixtures/.genieql",
+		Dialect:  dialect,
+		Driver:   drivers.StandardLib,
+	}
+}
+
+// DialectConfig9 dialect inserting a single row of defaults regardless of the number
+// of records. i.e.) sqlite when every column is defaulted.
+func DialectConfig9() genieql.Configuration {
+	const dialect = "test.dialect.9"
+	err := dialects.Register(dialect, dialects.TestFactory(dialects.Test{
+		Quote:       "\"",
+		QueryInsert: "INSERT INTO :gql.insert.tablename: DEFAULT VALUES RETURNING :gql.insert.returning:",
+	}))
+	if err != nil {
+		log.Println("failed to register test dialect", dialect, err)
+	}
+	return genieql.Configuration{
+		Location: ".fixtures/.genieql",
+		Dialect:  dialect,
+		Driver:   drivers.StandardLib,
+	}
+}
+
+// DialectConfig2 dialect using positional placeholders that is unable to bind arrays.
+func DialectConfig2() genieql.Configuration {
+	const dialect = "test.dialect.2"
+	err := dialects.Register(dialect, dialects.TestFactory(dialects.Test{
+		Quote: "\"",
+	}))
+	if err != nil {
+		log.Println("failed to register test dialect", dialect, err)
+	}
+	return genieql.Configuration{
+		Location: ".fixtures/.genieql",
+		Dialect:  dialect,
+		Driver:   drivers.StandardLib,
+	}
+}
+
+// DialectConfig3 dialect using positional placeholders that binds arrays.
+func DialectConfig3() genieql.Configuration {
+	const dialect = "test.dialect.3"
+	err := dialects.Register(dialect, dialects.TestFactory(dialects.Test{
+		Quote:  "\"",
+		Arrays: true,
+	}))
+	if err != nil {
+		log.Println("failed to register test dialect", dialect, err)
+	}
+	return genieql.Configuration{
+		Location: ".fixtures/.genieql",
+		Dialect:  dialect,
+		Driver:   drivers.StandardLib,
+	}
+}
+
+// DialectConfig8 dialect using positional placeholders that binds arrays
+// encoded by the pgx driver.
+func DialectConfig8() genieql.Configuration {
+	const dialect = "test.dialect.8"
+	err := dialects.Register(dialect, dialects.TestFactory(dialects.Test{
+		Quote:  "\"",
+		Arrays: true,
+	}))
+	if err != nil {
+		log.Println("failed to register test dialect", dialect, err)
+	}
+	return genieql.Configuration{
+		Location: ".fixtures/.genieql",
+		Dialect:  dialect,
+		Driver:   drivers.PGX,
+	}
+}
+
+// DialectConfig5 dialect binding arrays encoded by the pgx driver that validates
+// the generated queries, rejecting queries against the struct_b table.
+func DialectConfig5() genieql.Configuration {
+	const dialect = "test.dialect.5"
+	err := dialects.Register(dialect, dialects.TestFactory(dialects.Test{
+		Quote:  "\"",
+		Arrays: true,
+		Validation: func(query string) error {
+			if strings.Contains(query, "struct_b") {
+				return errors.New("relation \"struct_b\" does not exist")
+			}
+
+			return nil
+		},
+	}))
+	if err != nil {
+		log.Println("failed to register test dialect", dialect, err)
+	}
+	return genieql.Configuration{
+		Location: ".fixtures/.genieql",
+		Dialect:  dialect,
+		Driver:   drivers.PGX,
+		Validate: true,
+	}
+}
+
+// DialectConfig6 dialect validating the generated queries that expects an int
+// for every placeholder.
+func DialectConfig6() genieql.Configuration {
+	const dialect = "test.dialect.6"
+	err := dialects.Register(dialect, dialects.TestFactory(dialects.Test{
+		Quote: "\"",
+		ParameterTypes: func(d genieql.Driver, query string) (params []genieql.ColumnInfo, err error) {
+			for idx := range strings.Count(query, "?") {
+				def, err := d.LookupType("int")
+				if err != nil {
+					return nil, err
+				}
+
+				params = append(params, genieql.ColumnInfo{Name: fmt.Sprintf("$%d", idx+1), Definition: def})
+			}
+
+			return params, nil
+		},
+	}))
+	if err != nil {
+		log.Println("failed to register test dialect", dialect, err)
+	}
+	return genieql.Configuration{
+		Location: ".fixtures/.genieql",
+		Dialect:  dialect,
+		Driver:   drivers.StandardLib,
+		Validate: true,
+	}
+}
@@ -0,0 +1,302 @@
+package ginterp
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/generators"
+	"github.com/james-lawrence/genieql/generators/functions"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/stringsx"
+)
+
+// Paginate configuration interface for generating keyset pagination.
+// the generated function accepts an opaque cursor and a limit, returning
+// the page of rows along with the cursor for the next page. the next cursor
+// is empty when there are no more rows.
+type Paginate interface {
+	genieql.Generator        // must satisfy the generator interface
+	Table(string) Paginate   // what table to paginate
+	Keys(...string) Paginate // ordered columns that uniquely identify a row, used for the cursor.
+}
+
+func PaginateFromFile(cctx generators.Context, name string, tree *ast.File) (Paginate, error) {
+	var (
+		ok          bool
+		declPattern *ast.FuncType
+		pos         *ast.FuncDecl
+		scanner     *ast.FuncDecl // scanner to use for the results.
+		cf          *ast.Field
+		qf          *ast.Field
+		params      []*ast.Field
+	)
+
+	if pos = astcodec.FileFindDecl[*ast.FuncDecl](tree, astcodec.FindFunctionsByName(name)); pos == nil {
+		return nil, fmt.Errorf("unable to locate function declaration for paginate: %s", name)
+	}
+
+	// rewrite scanner declaration function.
+	if declPattern, ok = pos.Type.Params.List[1].Type.(*ast.FuncType); !ok {
+		return nil, errorsx.String("genieql.Paginate second parameter must be a function type")
+	}
+
+	if scanner = functions.DetectScanner(cctx, declPattern); scanner == nil {
+		return nil, errorsx.Errorf("genieql.Paginate %s - missing scanner", nodeInfo(cctx, pos))
+	}
+
+	if cf = functions.DetectContext(declPattern); cf != nil {
+		declPattern.Params.List = declPattern.Params.List[1:]
+	}
+
+	if qf = functions.DetectQueryer(declPattern); qf != nil {
+		declPattern.Params.List = declPattern.Params.List[1:]
+	}
+
+	if params = astutil.FlattenFields(declPattern.Params.List...); len(params) != 2 {
+		return nil, errorsx.Errorf("genieql.Paginate %s - expected the type to paginate and the limit; i.e.) func(ctx context.Context, q sqlx.Queryer, after Type, limit int)", nodeInfo(cctx, pos))
+	}
+
+	return NewPaginate(
+		cctx,
+		pos.Name.String(),
+		pos.Doc,
+		scanner,
+		cf,
+		qf,
+		params[0],
+		params[1],
+	), nil
+}
+
+// NewPaginate instantiate a new pagination generator. it uses the name of function
+// that calls Define as the name of the generated function.
+// the type of the after field is the type being paginated and is replaced by
+// an opaque cursor in the generated function.
+func NewPaginate(
+	ctx generators.Context,
+	name string,
+	comment *ast.CommentGroup,
+	scanner *ast.FuncDecl,
+	cf *ast.Field,
+	qf *ast.Field,
+	after *ast.Field,
+	limit *ast.Field,
+) Paginate {
+	return &paginate{
+		ctx:     ctx,
+		name:    name,
+		comment: comment,
+		qf:      sanitizePaginateField(qf),
+		cf:      sanitizePaginateField(cf),
+		after:   sanitizePaginateField(after),
+		limit:   sanitizePaginateField(limit),
+		scanner: scanner,
+	}
+}
+
+// sanitizePaginateField prevents the parameters from colliding with the
+// local variables of the generated function.
+func sanitizePaginateField(f *ast.Field) *ast.Field {
+	if f == nil {
+		return nil
+	}
+
+	return generators.SanitizeFieldIdents(func(i *ast.Ident) *ast.Ident {
+		switch i.Name {
+		case "first", "query", "err", "raw", "results", "scanner", "cursor", "r", "last":
+			return ast.NewIdent("_genieql_" + i.Name)
+		}
+
+		return i
+	}, f)[0]
+}
+
+type paginate struct {
+	ctx     generators.Context
+	name    string
+	table   string
+	keys    []string
+	after   *ast.Field    // type being paginated.
+	limit   *ast.Field    // maximum number of rows in a page.
+	cf      *ast.Field    // context field, can be nil.
+	qf      *ast.Field    // db Query field.
+	scanner *ast.FuncDecl // scanner being used for results.
+	comment *ast.CommentGroup
+}
+
+// Table specify the table being paginated.
+func (t *paginate) Table(s string) Paginate {
+	t.table = s
+	return t
+}
+
+// Keys specify the ordered columns used to page through the table.
+// together the columns must uniquely identify a row.
+func (t *paginate) Keys(columns ...string) Paginate {
+	t.keys = columns
+	return t
+}
+
+func (t *paginate) Generate(dst io.Writer) (err error) {
+	const tmpl = `func {{.Name}}({{ if .Context }}{{ .ContextName }} {{ .Context }}, {{ end }}{{ .QueryerName }} {{ .Queryer }}, {{ .After }} string, {{ .Limit }} {{ .LimitType }}) ([]{{ .Type }}, string, error) {
+	const first = ` + "`{{ .First }}`" + `
+	const query = ` + "`{{ .Query }}`" + `
+	var (
+		err     error
+		raw     []byte
+		results []{{ .Type }}
+		scanner {{ .ScannerType }}
+		cursor  struct {
+			{{- range $key := .Keys }}
+			{{ $key.Field }} {{ $key.Type }} ` + "`" + `json:"{{ $key.Column }}"` + "`" + `
+			{{- end }}
+		}
+	)
+
+	if {{ .After }} == "" {
+		scanner = {{ .Scanner }}({{ .QueryerName }}.{{ .QueryFunction }}({{ if .Context }}{{ .ContextName }}, {{ end }}first, {{ .Limit }}))
+	} else {
+		if raw, err = base64.RawURLEncoding.DecodeString({{ .After }}); err != nil {
+			return nil, "", err
+		}
+
+		if err = json.Unmarshal(raw, &cursor); err != nil {
+			return nil, "", err
+		}
+
+		scanner = {{ .Scanner }}({{ .QueryerName }}.{{ .QueryFunction }}({{ if .Context }}{{ .ContextName }}, {{ end }}query{{ range $key := .Keys }}, cursor.{{ $key.Field }}{{ end }}, {{ .Limit }}))
+	}
+	defer scanner.Close()
+
+	for scanner.Next() {
+		var r {{ .Type }}
+
+		if err = scanner.Scan(&r); err != nil {
+			return nil, "", err
+		}
+
+		results = append(results, r)
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(results) == 0 || len(results) < int({{ .Limit }}) {
+		return results, "", nil
+	}
+
+	last := results[len(results)-1]
+	{{- range $key := .Keys }}
+	cursor.{{ $key.Field }} = last.{{ $key.Field }}
+	{{- end }}
+
+	if raw, err = json.Marshal(cursor); err != nil {
+		return nil, "", err
+	}
+
+	return results, base64.RawURLEncoding.EncodeToString(raw), nil
+}
+`
+	type key struct {
+		Column string
+		Field  string
+		Type   string
+	}
+
+	type context struct {
+		Name          string
+		Context       string
+		ContextName   string
+		Queryer       string
+		QueryerName   string
+		After         string
+		Limit         string
+		LimitType     string
+		Type          string
+		Scanner       string
+		ScannerType   string
+		QueryFunction string
+		First         string
+		Query         string
+		Keys          []key
+	}
+
+	var (
+		cmaps []genieql.ColumnMap
+		keys  []key
+	)
+
+	t.ctx.Println("generation of", t.name, "initiated")
+	defer t.ctx.Println("generation of", t.name, "completed")
+	t.ctx.Debugln("paginate table", t.table)
+
+	if strings.TrimSpace(t.table) == "" {
+		return errorsx.Errorf("genieql.Paginate %s - table is required. use Table method to specify a table", t.name)
+	}
+
+	if len(t.keys) == 0 {
+		return errorsx.Errorf("genieql.Paginate %s - key columns are required. use Keys method to specify the columns", t.name)
+	}
+
+	if uniqueScanner(t.scanner) {
+		return errorsx.Errorf("genieql.Paginate %s - scanner %s must scan multiple rows", t.name, t.scanner.Name)
+	}
+
+	if cmaps, err = generators.ColumnMapFromFields(t.ctx, t.after); err != nil {
+		return errorsx.Wrap(err, "unable to generate mapping")
+	}
+
+	cset := genieql.ColumnMapSet(cmaps)
+	for _, column := range t.keys {
+		matches := cset.Filter(func(cm genieql.ColumnMap) bool { return cm.ColumnInfo.Name == column })
+		if len(matches) == 0 {
+			return errorsx.Errorf("genieql.Paginate %s - key column %s is not mapped by %s", t.name, column, types.ExprString(t.after.Type))
+		}
+
+		if matches[0].Definition.Nullable {
+			return errorsx.Errorf("genieql.Paginate %s - key column %s must not be nullable", t.name, column)
+		}
+
+		keys = append(keys, key{
+			Column: column,
+			Field:  matches[0].Field.Names[0].Name,
+			Type:   stringsx.DefaultIfBlank(matches[0].Definition.Override, matches[0].Definition.Native),
+		})
+	}
+
+	columns := cset.ColumnNames()
+	qfn, ctx, ctxname := "Query", "", ""
+	if t.cf != nil {
+		qfn, ctx, ctxname = "QueryContext", types.ExprString(t.cf.Type), t.cf.Names[0].Name
+	}
+
+	if err = generators.GenerateComment(generators.DefaultFunctionComment(t.name), t.comment).Generate(dst); err != nil {
+		return err
+	}
+
+	return template.Must(template.New("paginate template").Parse(tmpl)).Execute(dst, context{
+		Name:          t.name,
+		Context:       ctx,
+		ContextName:   ctxname,
+		Queryer:       types.ExprString(t.qf.Type),
+		QueryerName:   t.qf.Names[0].Name,
+		After:         t.after.Names[0].Name,
+		Limit:         t.limit.Names[0].Name,
+		LimitType:     types.ExprString(t.limit.Type),
+		Type:          types.ExprString(t.after.Type),
+		Scanner:       t.scanner.Name.Name,
+		ScannerType:   types.ExprString(t.scanner.Type.Results.List[0].Type),
+		QueryFunction: qfn,
+		First:         t.ctx.Dialect.Paginate(t.table, columns, t.keys, false),
+		Query:         t.ctx.Dialect.Paginate(t.table, columns, t.keys, true),
+		Keys:          keys,
+	})
+}
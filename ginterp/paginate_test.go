@@ -0,0 +1,121 @@
+package ginterp_test
+
+import (
+	"bytes"
+	"go/ast"
+	"go/token"
+	"io"
+
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/genieqltest"
+	. "github.com/james-lawrence/genieql/ginterp"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/membufx"
+	"github.com/james-lawrence/genieql/internal/testx"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Paginate", func() {
+	scanner := &ast.FuncDecl{
+		Name: ast.NewIdent("NewExampleScannerStatic"),
+		Type: astutil.MustParseExpr(token.NewFileSet(), "func(rows *sql.Rows, err error) ExampleScanner").(*ast.FuncType),
+	}
+	rowScanner := &ast.FuncDecl{
+		Name: ast.NewIdent("NewExampleScannerStaticRow"),
+		Type: astutil.MustParseExpr(token.NewFileSet(), "func(row *sql.Row) ExampleRowScanner").(*ast.FuncType),
+	}
+	config := DialectConfig1()
+	ctx, err := genieqltest.GeneratorContext(config)
+	errorsx.MaybePanic(err)
+
+	DescribeTable(
+		"examples",
+		func(in Paginate, out io.Reader) {
+			var (
+				b         = bytes.NewBufferString("package example\n")
+				formatted = bytes.NewBufferString("")
+			)
+
+			Expect(in.Generate(b)).To(Succeed())
+			Expect(astcodec.FormatOutput(formatted, b.Bytes())).To(Succeed())
+			Expect(formatted.String()).To(Equal(testx.IOString(out)))
+		},
+		Entry(
+			"example 1 - single key column",
+			NewPaginate(
+				ctx,
+				"PaginateExample1",
+				&ast.CommentGroup{
+					List: []*ast.Comment{
+						{Text: "// Basic Paginate Example"},
+					},
+				},
+				scanner,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("after")),
+				astutil.Field(ast.NewIdent("int"), ast.NewIdent("limit")),
+			).Table("struct_a").Keys("a"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/paginate/example.1.go"))),
+		),
+		Entry(
+			"example 2 - multiple key columns without a context",
+			NewPaginate(
+				ctx,
+				"PaginateExample2",
+				nil,
+				scanner,
+				nil,
+				astutil.Field(astutil.Expr("*sql.DB"), ast.NewIdent("db")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("cursor")),
+				astutil.Field(ast.NewIdent("uint64"), ast.NewIdent("n")),
+			).Table("struct_a").Keys("b", "a"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/paginate/example.2.go"))),
+		),
+	)
+
+	It("should require key columns", func() {
+		gen := NewPaginate(
+			ctx,
+			"PaginateExample3",
+			nil,
+			scanner,
+			astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+			astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+			astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("after")),
+			astutil.Field(ast.NewIdent("int"), ast.NewIdent("limit")),
+		).Table("struct_a")
+		Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("key columns are required")))
+	})
+
+	It("should reject unknown key columns", func() {
+		gen := NewPaginate(
+			ctx,
+			"PaginateExample4",
+			nil,
+			scanner,
+			astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+			astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+			astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("after")),
+			astutil.Field(ast.NewIdent("int"), ast.NewIdent("limit")),
+		).Table("struct_a").Keys("missing")
+		Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("key column missing is not mapped by StructA")))
+	})
+
+	It("should reject row scanners", func() {
+		gen := NewPaginate(
+			ctx,
+			"PaginateExample5",
+			nil,
+			rowScanner,
+			astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+			astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+			astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("after")),
+			astutil.Field(ast.NewIdent("int"), ast.NewIdent("limit")),
+		).Table("struct_a").Keys("a")
+		Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("must scan multiple rows")))
+	})
+})
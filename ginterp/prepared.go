@@ -0,0 +1,330 @@
+package ginterp
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/generators"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/stringsx"
+)
+
+// Prepared configuration interface for generating a registry of prepared statements.
+// the registry holds a *sql.Stmt for every function within the package that executes
+// a constant query and exposes methods mirroring those functions.
+// i.e.) func Prepared(gql genieql.Prepared)
+type Prepared interface {
+	genieql.Generator          // must satisfy the generator interface
+	Ignore(...string) Prepared // functions to exclude from the registry.
+}
+
+// PreparedFromFile builds the registry from the functions declared by the current package,
+// including the functions generated by genieql before the registry. the registry is generated
+// last, the functions of the current run are read from the scratch file of the compiler.
+// files generated by previous runs are excluded by their build constraint (!genieql.ignore)
+// so the registry never includes stale functions.
+func PreparedFromFile(cctx generators.Context, name string, tree *ast.File) (Prepared, error) {
+	var (
+		pos   *ast.FuncDecl
+		paths []string
+		files = map[string]*ast.File{}
+		fns   []*ast.FuncDecl
+	)
+
+	if pos = astcodec.FileFindDecl[*ast.FuncDecl](tree, astcodec.FindFunctionsByName(name)); pos == nil {
+		return nil, fmt.Errorf("unable to locate function declaration for prepared: %s", name)
+	}
+
+	err := genieql.NewUtils(cctx.FileSet).WalkFiles(func(path string, file *ast.File) {
+		paths = append(paths, path)
+		files[path] = file
+	}, cctx.CurrentPackage)
+	if err != nil {
+		return nil, errorsx.Wrap(err, "unable to parse package")
+	}
+
+	paths = slices.DeleteFunc(paths, func(path string) bool {
+		matched, err := cctx.Build.MatchFile(filepath.Dir(path), filepath.Base(path))
+		if err != nil {
+			cctx.Println("unable to evaluate build constraints", path, err)
+			return true
+		}
+
+		if !matched {
+			cctx.Debugln("excluding previously generated file", path)
+		}
+
+		return !matched
+	})
+
+	// ensure the output is stable.
+	sort.Strings(paths)
+	for _, path := range paths {
+		for _, d := range astcodec.SearchFileDecls(files[path], astcodec.FindFunctions) {
+			fns = append(fns, d.(*ast.FuncDecl))
+		}
+	}
+
+	return NewPrepared(cctx, pos.Name.String(), pos.Doc, fns...), nil
+}
+
+// NewPrepared instantiate a new prepared statement registry generator. it uses the name
+// of function that calls Define as the name of the generated type.
+// functions that do not execute a constant query are ignored.
+func NewPrepared(ctx generators.Context, name string, comment *ast.CommentGroup, fns ...*ast.FuncDecl) Prepared {
+	return &prepared{
+		ctx:     ctx,
+		name:    name,
+		comment: comment,
+		fns:     fns,
+	}
+}
+
+type prepared struct {
+	ctx     generators.Context
+	name    string
+	ignore  []string
+	fns     []*ast.FuncDecl
+	comment *ast.CommentGroup
+}
+
+// Ignore specify the functions to exclude from the registry.
+func (t *prepared) Ignore(fns ...string) Prepared {
+	t.ignore = fns
+	return t
+}
+
+func (t *prepared) Generate(dst io.Writer) (err error) {
+	const tmpl = `type {{ .Name }} struct {
+	{{- range $stmt := .Statements }}
+	{{ $stmt.Field }} *sql.Stmt
+	{{- end }}
+}
+
+// {{ .Constructor }} the statements of {{ .Name }}. the statements are closed when preparation fails.
+func {{ .Constructor }}(ctx context.Context, db interface {
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+}) (_ *{{ .Name }}, err error) {
+	var p {{ .Name }}
+	{{- range $stmt := .Statements }}
+	if p.{{ $stmt.Field }}, err = db.PrepareContext(ctx, {{ $stmt.Query }}); err != nil {
+		return nil, errors.Join(err, p.Close())
+	}
+	{{- end }}
+	return &p, nil
+}
+
+// Close the prepared statements.
+func (t *{{ .Name }}) Close() (err error) {
+	for _, stmt := range []*sql.Stmt{ {{- range $idx, $stmt := .Statements }}{{ if $idx }}, {{ end }}t.{{ $stmt.Field }}{{ end -}} } {
+		if stmt != nil {
+			err = errors.Join(err, stmt.Close())
+		}
+	}
+	return err
+}
+{{ range $stmt := .Statements }}
+{{ $stmt.Method }}
+{{ end }}`
+
+	type statement struct {
+		Field  string
+		Query  string
+		Method string
+	}
+
+	type context struct {
+		Name        string
+		Constructor string
+		Statements  []statement
+	}
+
+	t.ctx.Println("generation of", t.name, "initiated")
+	defer t.ctx.Println("generation of", t.name, "completed")
+
+	ctx := context{
+		Name:        t.name,
+		Constructor: "Prepare" + strings.TrimPrefix(t.name, "Prepared"),
+	}
+
+	for _, fn := range t.fns {
+		var (
+			method *ast.FuncDecl
+			query  *ast.BasicLit
+			doc    = bytes.NewBufferString("")
+		)
+
+		if slices.Contains(t.ignore, fn.Name.Name) || fn.Name.Name == t.name || fn.Name.Name == ctx.Constructor {
+			continue
+		}
+
+		field := stringsx.ToPrivate(fn.Name.Name)
+		if method, query = preparedMethod(t.name, field, fn); method == nil {
+			t.ctx.Debugln("prepared", t.name, "ignoring", fn.Name.Name, "does not execute a constant query")
+			continue
+		}
+
+		comment := fn.Doc
+		if comment == nil {
+			comment = generators.DefaultFunctionComment(fn.Name.Name)
+		}
+
+		if err = generators.GenerateComment(comment).Generate(doc); err != nil {
+			return err
+		}
+
+		ctx.Statements = append(ctx.Statements, statement{
+			Field:  field,
+			Query:  query.Value,
+			Method: doc.String() + astutil.MustPrint(method),
+		})
+	}
+
+	if len(ctx.Statements) == 0 {
+		return errorsx.Errorf("genieql.Prepared %s - no functions executing a constant query were found", t.name)
+	}
+
+	if err = generators.GenerateComment(generators.DefaultFunctionComment(t.name), t.comment).Generate(dst); err != nil {
+		return err
+	}
+
+	return template.Must(template.New("prepared template").Parse(tmpl)).Execute(dst, ctx)
+}
+
+// preparedMethod rewrites the function into a method of the registry executing the prepared
+// statement in place of the query. the function must declare a constant query and pass it
+// to the queryer parameter, i.e.) the functions generated by genieql.Function.
+func preparedMethod(recv string, field string, fn *ast.FuncDecl) (_ *ast.FuncDecl, query *ast.BasicLit) {
+	var (
+		err      error
+		tree     *ast.File
+		queryer  *ast.Ident
+		call     *ast.CallExpr
+		argidx   int
+		idents   = map[string]int{}
+		constidx = -1
+	)
+
+	if fn.Recv != nil || fn.Body == nil {
+		return nil, nil
+	}
+
+	// copy the function, the rewrite must not modify the source declaration.
+	if tree, err = parser.ParseFile(token.NewFileSet(), "", "package prepared\n"+astutil.MustPrint(fn), 0); err != nil {
+		return nil, nil
+	}
+	fn = tree.Decls[0].(*ast.FuncDecl)
+
+	for idx, stmt := range fn.Body.List {
+		if lit := preparedQuery(stmt); lit != nil {
+			constidx, query = idx, lit
+			break
+		}
+	}
+
+	if query == nil {
+		return nil, nil
+	}
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.Ident:
+			idents[x.Name]++
+		case *ast.CallExpr:
+			sel, ok := x.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+
+			recv, ok := sel.X.(*ast.Ident)
+			if !ok {
+				return true
+			}
+
+			idx := 0
+			switch sel.Sel.Name {
+			case "QueryContext", "QueryRowContext", "ExecContext":
+				idx = 1
+			case "Query", "QueryRow", "Exec":
+			default:
+				return true
+			}
+
+			if len(x.Args) > idx && types.ExprString(x.Args[idx]) == "query" {
+				call, queryer, argidx = x, recv, idx
+			}
+		}
+
+		return true
+	})
+
+	// the queryer and query must only be used by the call being replaced.
+	if call == nil || idents["query"] != 2 || idents[queryer.Name] != 1 {
+		return nil, nil
+	}
+
+	params := make([]*ast.Field, 0, len(fn.Type.Params.List))
+	found := false
+	for _, f := range fn.Type.Params.List {
+		if slices.ContainsFunc(f.Names, func(i *ast.Ident) bool { return i.Name == "t" }) {
+			return nil, nil
+		}
+
+		names := slices.DeleteFunc(slices.Clone(f.Names), func(i *ast.Ident) bool { return i.Name == queryer.Name })
+		found = found || len(names) != len(f.Names)
+		if len(names) == 0 {
+			continue
+		}
+		params = append(params, astutil.Field(f.Type, names...))
+	}
+
+	if !found || idents["t"] > 0 {
+		return nil, nil
+	}
+
+	fn.Recv = astutil.FieldList(astutil.Field(&ast.StarExpr{X: ast.NewIdent(recv)}, ast.NewIdent("t")))
+	fn.Type.Params.List = params
+	fn.Body.List = slices.Delete(fn.Body.List, constidx, constidx+1)
+	call.Fun = astutil.SelExpr(types.ExprString(astutil.SelExpr("t", field)), call.Fun.(*ast.SelectorExpr).Sel.Name)
+	call.Args = slices.Delete(call.Args, argidx, argidx+1)
+
+	return fn, query
+}
+
+// preparedQuery returns the literal of the constant query declaration, i.e.) const query = `SELECT 1`
+func preparedQuery(stmt ast.Stmt) *ast.BasicLit {
+	decl, ok := stmt.(*ast.DeclStmt)
+	if !ok {
+		return nil
+	}
+
+	gen, ok := decl.Decl.(*ast.GenDecl)
+	if !ok || gen.Tok != token.CONST || len(gen.Specs) != 1 {
+		return nil
+	}
+
+	spec, ok := gen.Specs[0].(*ast.ValueSpec)
+	if !ok || len(spec.Names) != 1 || spec.Names[0].Name != "query" || len(spec.Values) != 1 {
+		return nil
+	}
+
+	lit, ok := spec.Values[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return nil
+	}
+
+	return lit
+}
@@ -0,0 +1,107 @@
+package ginterp_test
+
+import (
+	"bytes"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/genieqltest"
+	. "github.com/james-lawrence/genieql/ginterp"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/membufx"
+	"github.com/james-lawrence/genieql/internal/testx"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Prepared", func() {
+	ctx, err := genieqltest.GeneratorContext(DialectConfig1())
+	errorsx.MaybePanic(err)
+
+	functions := func(paths ...string) (fns []*ast.FuncDecl) {
+		for _, path := range paths {
+			tree, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ParseComments)
+			errorsx.MaybePanic(err)
+			for _, d := range astcodec.SearchFileDecls(tree, astcodec.FindFunctions) {
+				fns = append(fns, d.(*ast.FuncDecl))
+			}
+		}
+
+		return fns
+	}
+
+	DescribeTable(
+		"examples",
+		func(in Prepared, out io.Reader) {
+			var (
+				b         = bytes.NewBufferString("package example\n")
+				formatted = bytes.NewBufferString("")
+			)
+
+			Expect(in.Generate(b)).To(Succeed())
+			Expect(astcodec.FormatOutput(formatted, b.Bytes())).To(Succeed())
+			Expect(formatted.String()).To(Equal(testx.IOString(out)))
+		},
+		Entry(
+			"example 1 - generated functions",
+			NewPrepared(
+				ctx,
+				"Prepared",
+				nil,
+				functions(
+					".fixtures/functions/example.3.go",
+					".fixtures/functions/example.5.go",
+					".fixtures/functions/example.8.go",
+					".fixtures/update/example.1.go",
+					".fixtures/delete/example.1.go",
+				)...,
+			),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/prepared/example.1.go"))),
+		),
+		Entry(
+			"example 2 - ignored functions",
+			NewPrepared(
+				ctx,
+				"PreparedExample2",
+				nil,
+				functions(
+					".fixtures/functions/example.3.go",
+					".fixtures/functions/example.8.go",
+				)...,
+			).Ignore("FunctionExample8"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/prepared/example.2.go"))),
+		),
+	)
+
+	It("should exclude the functions generated by previous runs", func() {
+		dir := GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(dir, "example.go"), []byte("//go:build genieql.generate\n\npackage example\n\nfunc Prepared(gql genieql.Prepared) {}\n"), 0600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "genieql.gen.go"), []byte("//go:build !genieql.ignore\n\npackage example\n\nfunc FunctionStale(ctx context.Context, q sqlx.Queryer) (sql.Result, error) {\n\tconst query = `DELETE FROM stale`\n\treturn q.ExecContext(ctx, query)\n}\n"), 0600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "genieql.tmp.1.go"), testx.Fixture(".fixtures/functions/example.8.go"), 0600)).To(Succeed())
+
+		pctx := ctx
+		pctx.CurrentPackage = &build.Package{Name: "example", Dir: dir, GoFiles: []string{"example.go", "genieql.gen.go", "genieql.tmp.1.go"}}
+		tree, err := parser.ParseFile(pctx.FileSet, filepath.Join(dir, "example.go"), nil, parser.ParseComments)
+		Expect(err).ToNot(HaveOccurred())
+
+		gen, err := PreparedFromFile(pctx, "Prepared", tree)
+		Expect(err).ToNot(HaveOccurred())
+
+		b := bytes.NewBufferString("package example\n")
+		Expect(gen.Generate(b)).To(Succeed())
+		Expect(b.String()).To(ContainSubstring("FunctionExample8"))
+		Expect(b.String()).ToNot(ContainSubstring("FunctionStale"))
+	})
+
+	It("requires a function executing a constant query", func() {
+		gen := NewPrepared(ctx, "Prepared", nil, functions(".fixtures/functions/example.5.go")...)
+		Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("no functions executing a constant query were found")))
+	})
+})
@@ -0,0 +1,16 @@
+//go:build !wasm
+
+package ginterp
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/james-lawrence/genieql/generators"
+)
+
+// readQueryFile reads the query from the file relative to the current package.
+func readQueryFile(ctx generators.Context, path string) (string, error) {
+	raw, err := os.ReadFile(filepath.Join(ctx.CurrentPackage.Dir, path))
+	return string(raw), err
+}
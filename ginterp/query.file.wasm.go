@@ -0,0 +1,35 @@
+//go:build wasm
+
+package ginterp
+
+import (
+	"unsafe"
+
+	"github.com/james-lawrence/genieql/generators"
+	"github.com/james-lawrence/genieql/internal/bytesx"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/wasix/ffiguest"
+)
+
+// readQueryFile reads the query from the file relative to the current package.
+// the host resolves the path since it has access to the package directory.
+func readQueryFile(ctx generators.Context, path string) (_ string, err error) {
+	var (
+		rs = make([]byte, 0, 2*bytesx.MiB)
+	)
+
+	pathptr, pathlen := ffiguest.String(path)
+	_, rptr, rlen := ffiguest.ByteBuffer(rs)
+	err = ffiguest.Error(
+		_readfile(pathptr, pathlen, unsafe.Pointer(&rlen), rptr),
+		errorsx.Errorf("unable to read query file: %s", path),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return string(ffiguest.ByteBufferRead(rptr, rlen)), nil
+}
+
+//go:wasmimport env genieql/ginterp.ReadFile
+func _readfile(pathptr unsafe.Pointer, pathlen uint32, rlen unsafe.Pointer, rptr unsafe.Pointer) (errcode uint32)
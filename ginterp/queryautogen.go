@@ -148,7 +148,6 @@ func (t *queryAutogen) Generate(dst io.Writer) (err error) {
 	mg := make([]genieql.Generator, 0, 10)
 	ignore := genieql.ColumnInfoFilterIgnore(t.ignore...)
 	names := genieql.ColumnInfoSet(details.Columns).ColumnNames()
-	// naturalKey := genieql.ColumnInfoSet(details.Columns).PrimaryKey()
 	defaults := functions.Query{
 		Context:      t.ctx,
 		Scanner:      t.scanner,
@@ -187,27 +186,102 @@ func (t *queryAutogen) Generate(dst io.Writer) (err error) {
 		mg = append(mg, g)
 	}
 
-	// if len(naturalKey) > 0 {
-	// 	query := details.Dialect.Select(details.Table, names, naturalKey.ColumnNames())
-	// 	options = []generators.QueryFunctionOption{
-	// 		queryerOption,
-	// 		generators.QFOSharedParameters(fieldFromColumnInfo(naturalKey...)...),
-	// 		generators.QFOBuiltinQueryFromString(query),
-	// 		generators.QFOName(fmt.Sprintf("%sFindByKey", t.Type)),
-	// 		generators.QFOScanner(t.UniqScanner),
-	// 	}
-	// 	mg = append(mg, generators.NewQueryFunction(options...))
-	// 	mg = append(mg, t.updateFunc(queryerOption, naturalKey, names))
-	// 	query = details.Dialect.Delete(details.Table, names, naturalKey.ColumnNames())
-	// 	options = []generators.QueryFunctionOption{
-	// 		queryerOption,
-	// 		generators.QFOSharedParameters(fieldFromColumnInfo(naturalKey...)...),
-	// 		generators.QFOBuiltinQueryFromString(query),
-	// 		generators.QFOName(fmt.Sprintf("%sDeleteByID", t.Type)),
-	// 		generators.QFOScanner(t.UniqScanner),
-	// 	}
-	// 	mg = append(mg, generators.NewQueryFunction(options...))
-	// }
+	// keyed functions use the primary key to locate a unique row, as such they're
+	// only generated when the scanner handles a single row.
+	if naturalKey := genieql.ColumnInfoSet(details.Columns).PrimaryKey(); len(naturalKey) > 0 && uniqueScanner(t.scanner) {
+		mg = append(mg, t.keyed(details, names, naturalKey)...)
+	}
 
 	return genieql.MultiGenerate(mg...).Generate(dst)
 }
+
+// keyed generates the FindByKey, UpdateByID, and DeleteByID functions
+// for the primary key of the table.
+func (t *queryAutogen) keyed(details genieql.TableDetails, names []string, naturalKey genieql.ColumnInfoSet) []genieql.Generator {
+	typename := types.ExprString(t.tf.Type)
+	keys := naturalKey.ColumnNames()
+	params := make([]*ast.Field, 0, len(naturalKey))
+	delparams := make([]*ast.Field, 0, len(naturalKey))
+	for _, column := range naturalKey {
+		name := snaker.SnakeToCamelLower(column.Name)
+		// the delete function matches the parameters to the key columns by name.
+		delparams = append(delparams, astutil.Field(ast.NewIdent(column.Definition.ColumnType), ast.NewIdent(name)))
+		// prevent collisions with the type parameter used by the update function.
+		for _, n := range t.tf.Names {
+			if n.Name == name {
+				name = name + "Key"
+			}
+		}
+		params = append(params, astutil.Field(ast.NewIdent(column.Definition.ColumnType), ast.NewIdent(name)))
+	}
+
+	find := genieql.NewFuncGenerator(func(dst io.Writer) (err error) {
+		var (
+			n ast.Node
+		)
+
+		name := typename + "FindByKey"
+		qfn := functions.Query{
+			Context:      t.ctx,
+			Scanner:      t.scanner,
+			Queryer:      t.qf.Type,
+			ContextField: t.cf,
+			Query:        astutil.StringLiteral(details.Dialect.Select(details.Table, names, keys)),
+		}
+
+		sig := &ast.FuncType{
+			Params: &ast.FieldList{
+				List: params,
+			},
+		}
+
+		if err = generators.GenerateComment(generators.DefaultFunctionComment(name)).Generate(dst); err != nil {
+			return err
+		}
+
+		if n, err = qfn.Compile(functions.New(name, sig)); err != nil {
+			return err
+		}
+
+		return printer.Fprint(dst, token.NewFileSet(), n)
+	})
+
+	// the update is restricted to the columns of the table, the type may map additional columns.
+	update := (&update{
+		ctx:     t.ctx,
+		name:    typename + "UpdateByID",
+		scanner: t.scanner,
+		cf:      t.cf,
+		qf:      t.qf,
+		tf:      t.tf,
+		columns: details.Columns,
+		params:  append(params, t.tf),
+	}).Table(details.Table).Ignore(append(keys, t.ignore...)...).Where(keys...)
+
+	del := NewDelete(
+		t.ctx,
+		typename+"DeleteByID",
+		nil,
+		t.scanner,
+		t.cf,
+		t.qf,
+		delparams...,
+	).Table(details.Table).Where(keys...)
+
+	// the update and delete functions read the modified row, as such they're
+	// only generated when the dialect returns the modified rows.
+	if !t.ctx.Dialect.Returning() {
+		return []genieql.Generator{find}
+	}
+
+	// tables consisting entirely of key and ignored columns have nothing to update.
+	if len(genieql.ColumnInfoSet(details.Columns).Filter(genieql.ColumnInfoFilterIgnore(append(keys, t.ignore...)...))) == 0 {
+		return []genieql.Generator{find, del}
+	}
+
+	return []genieql.Generator{find, update, del}
+}
+
+func uniqueScanner(scanner *ast.FuncDecl) bool {
+	return astutil.TypePattern(astutil.Expr("*sql.Row"))(astutil.MapFieldsToTypeExpr(scanner.Type.Params.List...)...)
+}
@@ -0,0 +1,88 @@
+package ginterp_test
+
+import (
+	"bytes"
+	"go/ast"
+	"go/token"
+	"io"
+
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/genieqltest"
+	. "github.com/james-lawrence/genieql/ginterp"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/membufx"
+	"github.com/james-lawrence/genieql/internal/testx"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("QueryAutogen", func() {
+	rowScanner := &ast.FuncDecl{
+		Name: ast.NewIdent("NewExampleScannerStaticRow"),
+		Type: astutil.MustParseExpr(token.NewFileSet(), "func(row *sql.Row) ExampleRowScanner").(*ast.FuncType),
+	}
+	rowsScanner := &ast.FuncDecl{
+		Name: ast.NewIdent("NewExampleScannerStatic"),
+		Type: astutil.MustParseExpr(token.NewFileSet(), "func(rows *sql.Rows, err error) ExampleScanner").(*ast.FuncType),
+	}
+	config := DialectConfig1()
+	ctx, err := genieqltest.GeneratorContext(config)
+	errorsx.MaybePanic(err)
+	noreturning, err := genieqltest.GeneratorContext(DialectConfig7())
+	errorsx.MaybePanic(err)
+
+	DescribeTable(
+		"examples",
+		func(in QueryAutogen, out io.Reader) {
+			var (
+				b         = bytes.NewBufferString("package example\n")
+				formatted = bytes.NewBufferString("")
+			)
+
+			Expect(in.Generate(b)).To(Succeed())
+			Expect(astcodec.FormatOutput(formatted, b.Bytes())).To(Succeed())
+			Expect(formatted.String()).To(Equal(testx.IOString(out)))
+		},
+		Entry(
+			"example 1 - composite primary key functions",
+			NewQueryAutogen(
+				ctx,
+				"StructAFindBy",
+				nil,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				rowScanner,
+			).From("struct_a_pk").Ignore("d"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/queryautogen/example.1.go"))),
+		),
+		Entry(
+			"example 2 - multi row scanners do not generate primary key functions",
+			NewQueryAutogen(
+				ctx,
+				"StructALookupBy",
+				nil,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				rowsScanner,
+			).From("struct_a_pk").Ignore("b", "c", "d"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/queryautogen/example.2.go"))),
+		),
+		Entry(
+			"example 3 - dialects unable to return the modified rows only find by the primary key",
+			NewQueryAutogen(
+				noreturning,
+				"StructAFindBy",
+				nil,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				rowScanner,
+			).From("struct_a_pk").Ignore("d"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/queryautogen/example.3.go"))),
+		),
+	)
+})
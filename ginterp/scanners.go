@@ -18,6 +18,9 @@ import (
 type Scanner interface {
 	genieql.Generator // must satisfy the generator interface
 	ColumnNamePrefix(string) Scanner
+	// Iterator additionally generate the range over func iterator and collect functions.
+	// i.e.) for v, err := range NewExampleIter(q.Query(...)) {}
+	Iterator() Scanner
 }
 
 func ScannerFromFile(cctx generators.Context, name string, tree *ast.File) (Scanner, error) {
@@ -58,6 +61,7 @@ type scanner struct {
 	ctx              generators.Context
 	params           *ast.FieldList
 	columnNamePrefix string
+	iterator         bool
 }
 
 func (t *scanner) ColumnNamePrefix(s string) Scanner {
@@ -65,6 +69,11 @@ func (t *scanner) ColumnNamePrefix(s string) Scanner {
 	return t
 }
 
+func (t *scanner) Iterator() Scanner {
+	t.iterator = true
+	return t
+}
+
 func (t *scanner) Generate(dst io.Writer) error {
 	t.ctx.Println("generation of", t.name, "initiated")
 	defer t.ctx.Println("generation of", t.name, "completed")
@@ -75,6 +84,11 @@ func (t *scanner) Generate(dst io.Writer) error {
 		modes = generators.ScannerOptionOutputMode(generators.ModeInterface | generators.ModeStatic | generators.ModeStaticDisableColumns)
 	}
 
+	iterator := generators.ScannerOptionNoop
+	if t.iterator {
+		iterator = generators.ScannerOptionEnableMode(generators.ModeIterator)
+	}
+
 	columnNamePrefix := generators.ScannerOptionNoop
 	if s := strings.TrimSpace(t.columnNamePrefix); s != "" {
 		columnNamePrefix = generators.ScannerOptionColumnNameTransformer(transformx.Prefix(s))
@@ -86,5 +100,6 @@ func (t *scanner) Generate(dst io.Writer) error {
 		generators.ScannerOptionParameters(t.params),
 		columnNamePrefix,
 		modes,
+		iterator,
 	).Generate(dst)
 }
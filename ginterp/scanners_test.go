@@ -0,0 +1,68 @@
+package ginterp_test
+
+import (
+	"bytes"
+	"go/ast"
+	"io"
+
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/genieqltest"
+	. "github.com/james-lawrence/genieql/ginterp"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/membufx"
+	"github.com/james-lawrence/genieql/internal/testx"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Scanner", func() {
+	config := DialectConfig1()
+	ctx, err := genieqltest.GeneratorContext(config)
+	errorsx.MaybePanic(err)
+
+	DescribeTable(
+		"examples",
+		func(in Scanner, out io.Reader) {
+			var (
+				b         = bytes.NewBufferString("package example\n")
+				formatted = bytes.NewBufferString("")
+			)
+
+			Expect(in.Generate(b)).To(Succeed())
+			Expect(astcodec.FormatOutput(formatted, b.Bytes())).To(Succeed())
+			Expect(formatted.String()).To(Equal(testx.IOString(out)))
+		},
+		Entry(
+			"example 1 - decode through overridden types",
+			NewScanner(
+				ctx,
+				"ScannerExample1",
+				astutil.FieldList(astutil.Field(ast.NewIdent("StructC"), ast.NewIdent("c"))),
+			),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/scanners/example.1.go"))),
+		),
+		Entry(
+			"example 2 - iterator",
+			NewScanner(
+				ctx,
+				"ScannerExample2",
+				astutil.FieldList(astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a"))),
+			).Iterator(),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/scanners/example.2.go"))),
+		),
+	)
+
+	It("should reject iterators for multiple parameters", func() {
+		gen := NewScanner(
+			ctx,
+			"ScannerExample3",
+			astutil.FieldList(
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("b")),
+			),
+		).Iterator()
+		Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("iterator scanners require exactly one parameter")))
+	})
+})
@@ -3,7 +3,9 @@ package ginterp
 import (
 	"fmt"
 	"go/ast"
+	"go/token"
 	"io"
+	"slices"
 
 	"github.com/james-lawrence/genieql"
 	"github.com/james-lawrence/genieql/astcodec"
@@ -20,7 +22,17 @@ type Structure interface {
 	Query(string) definition
 	// Ignore specify column names to exclude from the generated structure.
 	Ignore(...string) Structure
-	// OptionTransformColumns(x ...func(genieql.ColumnInfo) genieql.ColumnInfo) Structure
+	// Transform specify the transformations used to generate the field names
+	// of the structure. the transformations are applied in order to each column,
+	// the resulting names must be exported identifiers. i.e.) Transform(Lowercase, Camelcase)
+	Transform(x ...func(genieql.ColumnInfo) genieql.ColumnInfo) Structure
+	// Type override the golang type of the column's field. the type must be
+	// convertible to and from the type the driver uses for the column.
+	Type(column string, typ string) Structure
+	// Tags specify templates used to generate the struct tags for each field.
+	// the templates have access to the .Column and .Field name.
+	// i.e.) `json:"{{ .Column.Name }},omitempty"`
+	Tags(...string) Structure
 }
 
 func StructureFromFile(cctx generators.Context, name string, tree *ast.File) (Structure, error) {
@@ -46,14 +58,21 @@ func NewStructure(ctx generators.Context, name string, comment *ast.CommentGroup
 }
 
 type sconfig struct {
-	name    string
-	comment *ast.CommentGroup
-	d       definition
-	ignore  []string
-	ctx     generators.Context
+	name       string
+	comment    *ast.CommentGroup
+	d          definition
+	ignore     []string
+	transforms []func(genieql.ColumnInfo) genieql.ColumnInfo
+	types      map[string]string
+	tags       []string
+	ctx        generators.Context
 }
 
 func (t *sconfig) Generate(dst io.Writer) error {
+	var (
+		renames = map[string]string{}
+	)
+
 	if t.d == nil {
 		return errorsx.String("missing definition, unable to generate structure. please call the From method")
 	}
@@ -70,8 +89,36 @@ func (t *sconfig) Generate(dst io.Writer) error {
 			if err != nil {
 				return nil, err
 			}
-			return genieql.ColumnInfoSet(columns).Filter(genieql.ColumnInfoFilterIgnore(t.ignore...)), nil
+			columns = genieql.ColumnInfoSet(columns).Filter(genieql.ColumnInfoFilterIgnore(t.ignore...))
+
+			for column, typ := range t.types {
+				idx := slices.IndexFunc(columns, func(c genieql.ColumnInfo) bool { return c.Name == column })
+				if idx < 0 {
+					return nil, errorsx.Errorf("genieql.Structure %s - unable to override type of unknown column %s", t.name, column)
+				}
+				columns[idx].Definition.Override = typ
+			}
+
+			// record the transformed names in the rename map so they're persisted
+			// with the mapping and used consistently by the scanners.
+			for _, c := range columns {
+				transformed, ok := t.transform(c)
+				if !ok {
+					continue
+				}
+
+				// the scanners assign the fields from other packages.
+				if !token.IsIdentifier(transformed.Name) || !token.IsExported(transformed.Name) {
+					return nil, errorsx.Errorf("genieql.Structure %s - column %s transformed into %s, field names must be exported identifiers", t.name, c.Name, transformed.Name)
+				}
+
+				renames[c.Name] = transformed.Name
+			}
+
+			return columns, nil
 		}),
+		generators.StructOptionRenameMap(renames),
+		generators.StructOptionTags(t.tags...),
 		generators.StructOptionMappingConfigOptions(
 			genieql.MCOPackage(t.ctx.CurrentPackage),
 		),
@@ -88,6 +135,25 @@ func (t *sconfig) Ignore(ignore ...string) Structure {
 	return t
 }
 
+func (t *sconfig) Transform(x ...func(genieql.ColumnInfo) genieql.ColumnInfo) Structure {
+	t.transforms = x
+	return t
+}
+
+func (t *sconfig) Tags(tags ...string) Structure {
+	t.tags = tags
+	return t
+}
+
+func (t *sconfig) Type(column string, typ string) Structure {
+	if t.types == nil {
+		t.types = make(map[string]string)
+	}
+
+	t.types[column] = typ
+	return t
+}
+
 func (t sconfig) Table(s string) definition {
 	return Table(t.ctx.Driver, t.ctx.Dialect, s)
 }
@@ -95,3 +161,13 @@ func (t sconfig) Table(s string) definition {
 func (t sconfig) Query(s string) definition {
 	return Query(t.ctx.Driver, t.ctx.Dialect, s)
 }
+
+// transform applies the transformations to the column, returns false when
+// no transformations are specified.
+func (t sconfig) transform(c genieql.ColumnInfo) (genieql.ColumnInfo, bool) {
+	for _, x := range t.transforms {
+		c = x(c)
+	}
+
+	return c, len(t.transforms) > 0
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 
+	"github.com/james-lawrence/genieql"
 	"github.com/james-lawrence/genieql/astcodec"
 	"github.com/james-lawrence/genieql/genieqltest"
 	. "github.com/james-lawrence/genieql/ginterp"
@@ -51,5 +52,82 @@ var _ = Describe("Structure", func() {
 			}(),
 			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/structures/example.2.go"))),
 		),
+		Entry(
+			"example 3 - default field names",
+			func() Structure {
+				s := NewStructure(ctx, "StructureExample3", nil)
+				s.From(s.Table("struct_snakecase"))
+				return s
+			}(),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/structures/example.3.go"))),
+		),
+		Entry(
+			"example 4 - transformed field names",
+			func() Structure {
+				s := NewStructure(ctx, "StructureExample4", nil)
+				s.From(s.Table("struct_snakecase")).Transform(Lowercase, Camelcase)
+				return s
+			}(),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/structures/example.4.go"))),
+		),
+		Entry(
+			"example 5 - type overrides",
+			func() Structure {
+				s := NewStructure(ctx, "StructureExample5", nil)
+				s.From(s.Table("struct_snakecase")).Type("uuid_field", "UUID")
+				return s
+			}(),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/structures/example.5.go"))),
+		),
+		Entry(
+			"example 6 - struct tags",
+			func() Structure {
+				s := NewStructure(ctx, "StructureExample6", nil)
+				s.From(s.Table("struct_snakecase")).Tags(`json:"{{ .Column.Name }},omitempty"`, `db:"{{ .Column.Name }}"`)
+				return s
+			}(),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/structures/example.6.go"))),
+		),
+		Entry(
+			"example 7 - enumerated columns",
+			func() Structure {
+				s := NewStructure(ctx, "StructureExample8", nil)
+				s.From(s.Table("struct_enum")).Type("mood", "Mood")
+				return s
+			}(),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/structures/example.7.go"))),
+		),
+		Entry(
+			"example 8 - enumerated columns without a declared enum",
+			func() Structure {
+				s := NewStructure(ctx, "StructureExample10", nil)
+				s.From(s.Table("struct_enum"))
+				return s
+			}(),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/structures/example.8.go"))),
+		),
+	)
+
+	It("should reject type overrides for unknown columns", func() {
+		s := NewStructure(ctx, "StructureExample7", nil)
+		s.From(s.Table("struct_snakecase")).Type("missing", "UUID")
+		Expect(s.Generate(io.Discard)).To(MatchError(ContainSubstring("unknown column missing")))
+	})
+
+	It("should reject transformations producing unexported field names", func() {
+		s := NewStructure(ctx, "StructureExample9", nil)
+		s.From(s.Table("struct_snakecase")).Transform(Snakecase)
+		Expect(s.Generate(io.Discard)).To(MatchError(ContainSubstring("must be exported identifiers")))
+	})
+
+	DescribeTable(
+		"transformations",
+		func(x func(genieql.ColumnInfo) genieql.ColumnInfo, name, expected string) {
+			Expect(x(genieql.ColumnInfo{Name: name}).Name).To(Equal(expected))
+		},
+		Entry("camelcase", Camelcase, "uuid_field", "UuidField"),
+		Entry("snakecase", Snakecase, "UuidField", "uuid_field"),
+		Entry("lowercase", Lowercase, "UUID_Field", "uuid_field"),
+		Entry("uppercase", Uppercase, "uuid_field", "UUID_FIELD"),
 	)
 })
@@ -0,0 +1,481 @@
+package ginterp
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"io"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/generators"
+	"github.com/james-lawrence/genieql/generators/functions"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/stringsx"
+)
+
+// UpdateBatch configuration interface for generating batch updates.
+// the records are updated by a single statement joining the table against
+// the values of the records, the updated rows stream through the scanner.
+type UpdateBatch interface {
+	genieql.Generator             // must satisfy the generator interface
+	Table(string) UpdateBatch     // what table to update
+	Ignore(...string) UpdateBatch // do not attempt to update the specified columns.
+	Where(...string) UpdateBatch  // columns used to match the records to the rows of the table.
+}
+
+func UpdateBatchFromFile(cctx generators.Context, name string, tree *ast.File) (UpdateBatch, error) {
+	var (
+		pos     *ast.FuncDecl
+		scanner *ast.FuncDecl
+		cf      *ast.Field
+		qf      *ast.Field
+		tf      *ast.Field
+		err     error
+	)
+
+	if pos = astcodec.FileFindDecl[*ast.FuncDecl](tree, astcodec.FindFunctionsByName(name)); pos == nil {
+		return nil, fmt.Errorf("unable to locate function declaration for update batch: %s", name)
+	}
+
+	if scanner, cf, qf, tf, err = batchModifyFields(cctx, "genieql.UpdateBatch", pos); err != nil {
+		return nil, err
+	}
+
+	return NewUpdateBatch(
+		cctx,
+		pos.Name.String(),
+		pos.Doc,
+		cf,
+		qf,
+		tf,
+		scanner,
+	), nil
+}
+
+// NewUpdateBatch instantiate a new batch update generator. it uses the name of function
+// that calls Define as the name of the generated function.
+func NewUpdateBatch(
+	ctx generators.Context,
+	name string,
+	comment *ast.CommentGroup,
+	cf *ast.Field,
+	qf *ast.Field,
+	tf *ast.Field,
+	scanner *ast.FuncDecl,
+) UpdateBatch {
+	return &updatebatch{
+		ctx:     ctx,
+		name:    name,
+		comment: comment,
+		cf:      sanitizeBatchModifyField(cf),
+		qf:      sanitizeBatchModifyField(qf),
+		tf:      sanitizeBatchModifyField(tf),
+		scanner: scanner,
+	}
+}
+
+type updatebatch struct {
+	ctx     generators.Context
+	name    string
+	table   string
+	ignore  []string
+	where   []string
+	tf      *ast.Field    // type field.
+	cf      *ast.Field    // context field, can be nil.
+	qf      *ast.Field    // db Query field.
+	scanner *ast.FuncDecl // scanner being used for results.
+	comment *ast.CommentGroup
+}
+
+// Table specify the table being updated.
+func (t *updatebatch) Table(s string) UpdateBatch {
+	t.table = s
+	return t
+}
+
+// Ignore specify the table columns to leave untouched during the update.
+// ignored columns are still returned by the query.
+func (t *updatebatch) Ignore(ignore ...string) UpdateBatch {
+	t.ignore = ignore
+	return t
+}
+
+// Where specify the table columns used to match the records to rows.
+func (t *updatebatch) Where(columns ...string) UpdateBatch {
+	t.where = columns
+	return t
+}
+
+func (t *updatebatch) Generate(dst io.Writer) (err error) {
+	var (
+		cmaps    []genieql.ColumnMap
+		keycmaps []genieql.ColumnMap
+	)
+
+	t.ctx.Println("generation of", t.name, "initiated")
+	defer t.ctx.Println("generation of", t.name, "completed")
+	t.ctx.Debugln("update batch table", t.table)
+
+	if strings.TrimSpace(t.table) == "" {
+		return errorsx.Errorf("genieql.UpdateBatch %s - table is required. use Table method to specify a table", t.name)
+	}
+
+	if err = returning(t.ctx, "genieql.UpdateBatch", t.name); err != nil {
+		return err
+	}
+
+	if len(t.where) == 0 {
+		return errorsx.Errorf("genieql.UpdateBatch %s - predicate columns are required. use Where method to specify the columns", t.name)
+	}
+
+	if cmaps, err = generators.ColumnMapFromFields(t.ctx, t.tf); err != nil {
+		return errorsx.Wrap(err, "unable to generate mapping")
+	}
+
+	cset := genieql.ColumnMapSet(cmaps)
+	if keycmaps, err = batchModifyKeys(cset, t.where); err != nil {
+		return errorsx.Wrapf(err, "genieql.UpdateBatch %s", t.name)
+	}
+
+	// the key columns are always bound, even when ignored. generated columns are
+	// computed by the database, as such they're never updated.
+	values := cset.Filter(func(cm genieql.ColumnMap) bool {
+		return (genieql.ColumnInfoFilterIgnore(t.ignore...)(cm.ColumnInfo) && genieql.NotGeneratedFilter(cm.ColumnInfo)) || slices.Contains(t.where, cm.ColumnInfo.Name)
+	})
+
+	if len(values) == len(keycmaps) {
+		return errorsx.Errorf("genieql.UpdateBatch %s - no columns to update", t.name)
+	}
+
+	statement := func(n int) string {
+		return t.ctx.Dialect.UpdateBatch(n, t.table, values.ColumnNames(), batchModifyTypes(values), t.where, cset.ColumnNames())
+	}
+
+	return batchModify{
+		ctx:       t.ctx,
+		name:      t.name,
+		comment:   t.comment,
+		cf:        t.cf,
+		qf:        t.qf,
+		tf:        t.tf,
+		scanner:   t.scanner,
+		values:    values,
+		statement: statement,
+	}.Generate(dst)
+}
+
+// batchModifyFields detects the fields of batch update and delete declarations.
+// i.e.) func(ctx context.Context, q sqlx.Queryer, a Type) NewTypeScannerStatic
+func batchModifyFields(cctx generators.Context, kind string, pos *ast.FuncDecl) (scanner *ast.FuncDecl, cf, qf, tf *ast.Field, err error) {
+	var (
+		ok          bool
+		declPattern *ast.FuncType
+	)
+
+	if declPattern, ok = pos.Type.Params.List[1].Type.(*ast.FuncType); !ok {
+		return nil, nil, nil, nil, errorsx.Errorf("%s second parameter must be a function type", kind)
+	}
+
+	if scanner = functions.DetectScanner(cctx, declPattern); scanner == nil {
+		return nil, nil, nil, nil, errorsx.Errorf("%s %s - missing scanner", kind, nodeInfo(cctx, pos))
+	}
+
+	if cf = functions.DetectContext(declPattern); cf != nil {
+		declPattern.Params.List = declPattern.Params.List[1:]
+	}
+
+	if qf = functions.DetectQueryer(declPattern); qf != nil {
+		declPattern.Params.List = declPattern.Params.List[1:]
+	}
+
+	if params := astutil.FlattenFields(declPattern.Params.List...); qf == nil || len(params) != 1 {
+		return nil, nil, nil, nil, errorsx.Errorf("%s %s - expected a queryer and the type of the records; i.e.) func(ctx context.Context, q sqlx.Queryer, a Type) NewTypeScannerStatic", kind, nodeInfo(cctx, pos))
+	}
+
+	return scanner, cf, qf, astutil.FlattenFields(declPattern.Params.List...)[0], nil
+}
+
+// batchModifyKeys locates the columns matching the records to the rows of the table.
+func batchModifyKeys(cset genieql.ColumnMapSet, where []string) (keys []genieql.ColumnMap, err error) {
+	for _, column := range where {
+		idx := slices.IndexFunc(cset, func(cm genieql.ColumnMap) bool { return cm.ColumnInfo.Name == column })
+		if idx < 0 {
+			return nil, errorsx.Errorf("predicate column %s is not mapped by the type", column)
+		}
+
+		keys = append(keys, cset[idx])
+	}
+
+	return keys, nil
+}
+
+// batchModifyTypes the database types of the columns.
+func batchModifyTypes(cmaps genieql.ColumnMapSet) []string {
+	types := make([]string, 0, len(cmaps))
+	for _, c := range cmaps {
+		types = append(types, c.ColumnInfo.Definition.DBTypeName)
+	}
+
+	return types
+}
+
+// sanitizeBatchModifyField prevents the parameters from colliding with the
+// local variables of the generated functions.
+func sanitizeBatchModifyField(f *ast.Field) *ast.Field {
+	if f == nil {
+		return nil
+	}
+
+	return generators.SanitizeFieldIdents(func(i *ast.Ident) *ast.Ident {
+		switch i.Name {
+		case "t", "n", "i", "err", "transform", "query", "queryPrefix", "querySuffix", "valueTuple", "valueTuples", "args", "remaining", "scanner":
+			return ast.NewIdent("_genieql_" + i.Name)
+		}
+
+		return i
+	}, f)[0]
+}
+
+// batchModify generates the scanner executing batch updates and deletes. the records
+// are bound as one array per column when the dialect binds arrays, otherwise as tuples
+// of placeholders chunked by the parameter limit of the dialect.
+type batchModify struct {
+	ctx       generators.Context
+	name      string
+	comment   *ast.CommentGroup
+	cf        *ast.Field
+	qf        *ast.Field
+	tf        *ast.Field
+	scanner   *ast.FuncDecl
+	values    genieql.ColumnMapSet
+	statement func(n int) string // generates the statement for n records.
+}
+
+func (t batchModify) Generate(dst io.Writer) (err error) {
+	const tmpl = `func New{{ .Name | public }}({{ if .Context }}{{ .ContextName }} {{ .Context }}, {{ end }}{{ .QueryerName }} {{ .Queryer }}, {{ .Record }} ...{{ .Type }}) {{ .ScannerType }} {
+	return &{{ .Name | private }}{ {{- if .Context }}{{ .ContextName }}: {{ .ContextName }}, {{ end }}{{ .QueryerName }}: {{ .QueryerName }}, remaining: {{ .Record }}}
+}
+
+type {{ .Name | private }} struct {
+	{{- if .Context }}
+	{{ .ContextName }} {{ .Context }}
+	{{- end }}
+	{{ .QueryerName }} {{ .Queryer }}
+	remaining []{{ .Type }}
+	scanner {{ .ScannerType }}
+}
+
+func (t *{{ .Name | private }}) Scan({{ .Record }} *{{ .Type }}) error {
+	return t.scanner.Scan({{ .Record }})
+}
+
+func (t *{{ .Name | private }}) Err() error {
+	if t.scanner == nil {
+		return nil
+	}
+	return t.scanner.Err()
+}
+
+func (t *{{ .Name | private }}) Close() error {
+	if t.scanner == nil {
+		return nil
+	}
+	return t.scanner.Close()
+}
+
+func (t *{{ .Name | private }}) Next() bool {
+	var advanced bool
+	if t.scanner != nil && t.scanner.Next() {
+		return true
+	}
+	if len(t.remaining) > 0 && t.Close() == nil {
+		t.scanner, t.remaining, advanced = t.advance(t.remaining...)
+		return advanced && t.scanner.Next()
+	}
+	return false
+}
+
+func (t *{{ .Name | private }}) advance({{ .Record }} ...{{ .Type }}) ({{ .ScannerType }}, []{{ .Type }}, bool) {
+	transform := {{ .Transform }}
+	if len({{ .Record }}) == 0 {
+		return nil, []{{ .Type }}(nil), false
+	}
+	n := {{ .Size }}
+	{{- if .Columnar }}
+	const query = ` + "`{{ .Query }}`" + `
+	{{- range $idx, $column := .Columns }}
+	v{{ $idx }} := make([]{{ $column.Type }}, 0, n)
+	{{- end }}
+	for i := range n {
+		{{ .Locals }}, err := transform({{ .Record }}[i])
+		if err != nil {
+			return {{ .ErrExpr }}, []{{ .Type }}(nil), false
+		}
+		{{- range $idx, $column := .Columns }}
+		v{{ $idx }} = append(v{{ $idx }}, {{ $column.Name }})
+		{{- end }}
+	}
+	return {{ .Scanner }}(t.{{ .QueryerName }}.QueryContext({{ .ContextExpr }}, query{{ range $idx, $column := .Columns }}, v{{ $idx }}{{ end }})), {{ .Record }}[n:], true
+	{{- else }}
+	const queryPrefix = ` + "`{{ .QueryPrefix }}`" + `
+	const querySuffix = ` + "`{{ .QuerySuffix }}`" + `
+	const valueTuple = ` + "`{{ .Tuple }}`" + `
+	valueTuples := make([]string, 0, n)
+	args := make([]any, 0, n*{{ len .Columns }})
+	for i := range n {
+		valueTuples = append(valueTuples, {{ .TupleExpr }})
+		{{ .Locals }}, err := transform({{ .Record }}[i])
+		if err != nil {
+			return {{ .ErrExpr }}, []{{ .Type }}(nil), false
+		}
+		args = append(args, {{ .Locals }})
+	}
+	query := queryPrefix + strings.Join(valueTuples, ` + "`,`" + `) + querySuffix
+	return {{ .Scanner }}(t.{{ .QueryerName }}.QueryContext({{ .ContextExpr }}, query, args...)), {{ .Record }}[n:], true
+	{{- end }}
+}
+`
+	type column struct {
+		Name string
+		Type string
+	}
+
+	type context struct {
+		Name        string
+		Context     string
+		ContextName string
+		ContextExpr string
+		Queryer     string
+		QueryerName string
+		Record      string
+		Type        string
+		Scanner     string
+		ScannerType string
+		ErrExpr     string
+		Transform   string
+		Locals      string
+		Size        string
+		Columnar    bool
+		Query       string
+		QueryPrefix string
+		QuerySuffix string
+		Tuple       string
+		TupleExpr   string
+		Columns     []column
+	}
+
+	var (
+		encodings   []ast.Stmt
+		explodedecl *ast.FuncDecl
+	)
+
+	queryfields := generators.QueryFieldsFromColumnMap(t.ctx, t.values.Map(func(idx int, cm genieql.ColumnMap) genieql.ColumnMap {
+		dup := cm
+		dup.Field = astutil.Field(astutil.MustParseExpr(t.ctx.FileSet, cm.Definition.ColumnType), cm.Local(idx))
+		return dup
+	})...)
+
+	explodeerrHandler := func(errlocal string) ast.Node {
+		explodereturn := make([]ast.Expr, 0, len(queryfields)+1)
+		explodereturn = append(explodereturn, astutil.MapFieldsToNameExpr(queryfields...)...)
+		explodereturn = append(explodereturn, ast.NewIdent(errlocal))
+		return astutil.Return(explodereturn...)
+	}
+
+	if _, encodings, _, err = generators.QueryInputsFromColumnMap(t.ctx, t.scanner, explodeerrHandler, t.values...); err != nil {
+		return errorsx.Wrap(err, "unable to transform query inputs")
+	}
+
+	explodereturn := append(astutil.MapFieldsToNameExpr(queryfields...), ast.NewIdent("nil"))
+	explodefn := functions.NewFn(append(encodings, astutil.Return(explodereturn...))...)
+	explodesig := &ast.FuncType{
+		Params: &ast.FieldList{
+			List: []*ast.Field{
+				t.tf,
+			},
+		},
+		Results: &ast.FieldList{
+			List: append(slices.Clone(queryfields), astutil.Field(ast.NewIdent("error"), ast.NewIdent("err"))),
+		},
+	}
+
+	if explodedecl, err = explodefn.Compile(functions.New("", explodesig)); err != nil {
+		return errorsx.Wrap(err, "failed to generate encoding function")
+	}
+
+	ctx := context{
+		Name:        t.name,
+		ContextExpr: "context.Background()",
+		Queryer:     types.ExprString(t.qf.Type),
+		QueryerName: t.qf.Names[0].Name,
+		Record:      t.tf.Names[0].Name,
+		Type:        types.ExprString(t.tf.Type),
+		Scanner:     t.scanner.Name.Name,
+		ScannerType: types.ExprString(t.scanner.Type.Results.List[0].Type),
+		ErrExpr:     types.ExprString(generators.ScannerErrorHandlingExpr(t.scanner)("err")),
+		Transform:   astutil.MustPrint(astutil.FuncLiteral(explodedecl)),
+		Size:        "len(" + t.tf.Names[0].Name + ")",
+	}
+
+	if t.cf != nil {
+		ctx.Context, ctx.ContextName = types.ExprString(t.cf.Type), t.cf.Names[0].Name
+		ctx.ContextExpr = "t." + ctx.ContextName
+	}
+
+	locals := make([]string, 0, len(queryfields))
+	for _, f := range queryfields {
+		locals = append(locals, f.Names[0].Name)
+		ctx.Columns = append(ctx.Columns, column{Name: f.Names[0].Name, Type: types.ExprString(f.Type)})
+	}
+	ctx.Locals = strings.Join(locals, ", ")
+
+	statement := func(n int) string {
+		return functions.QueryLiteralColumnMapReplacer(t.ctx, t.statement(n), t.values...)
+	}
+
+	// dialects binding arrays generate the same statement regardless of the number of records.
+	if ctx.Columnar = statement(1) == statement(2); ctx.Columnar {
+		// arrays are cast to the database type of the column.
+		if idx := slices.IndexFunc(t.values, func(cm genieql.ColumnMap) bool { return cm.ColumnInfo.Definition.DBTypeName == "" }); idx >= 0 {
+			return errorsx.Errorf("genieql %s - unable to bind column %s as an array, unknown database type %s", t.name, t.values[idx].ColumnInfo.Name, t.values[idx].ColumnInfo.Definition.Type)
+		}
+
+		ctx.Query = statement(1)
+	} else {
+		var (
+			args []ast.Expr
+		)
+
+		prefix, remaining, ok := strings.Cut(statement(1), "VALUES ")
+		if !ok {
+			return errorsx.Errorf("genieql %s - unable to locate the values of the batch statement: %s", t.name, statement(1))
+		}
+
+		end := strings.IndexByte(remaining, ')') + 1
+		ctx.QueryPrefix, ctx.QuerySuffix = prefix+"VALUES ", remaining[end:]
+
+		ctx.Tuple, args = batchTupleFormat(remaining[:end], len(queryfields))
+		ctx.TupleExpr = "valueTuple"
+		if len(args) > 0 {
+			ctx.TupleExpr = types.ExprString(astutil.CallExpr(astutil.SelExpr("fmt", "Sprintf"), append([]ast.Expr{ast.NewIdent("valueTuple")}, args...)...))
+		}
+
+		if limit := t.ctx.Dialect.MaxParameters(); limit > 0 {
+			ctx.Size = fmt.Sprintf("min(%s, %d)", ctx.Size, max(limit/max(len(queryfields), 1), 1))
+		}
+	}
+
+	if err = generators.GenerateComment(generators.DefaultFunctionComment(t.name), t.comment).Generate(dst); err != nil {
+		return err
+	}
+
+	return template.Must(template.New("batch modify template").Funcs(template.FuncMap{
+		"public":  stringsx.ToPublic,
+		"private": stringsx.ToPrivate,
+	}).Parse(tmpl)).Execute(dst, ctx)
+}
@@ -0,0 +1,146 @@
+package ginterp_test
+
+import (
+	"bytes"
+	"go/ast"
+	"go/token"
+	"io"
+
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/genieqltest"
+	. "github.com/james-lawrence/genieql/ginterp"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/membufx"
+	"github.com/james-lawrence/genieql/internal/testx"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Batch Update", func() {
+	rowsScanner := &ast.FuncDecl{
+		Name: ast.NewIdent("NewExampleScannerStatic"),
+		Type: astutil.MustParseExpr(token.NewFileSet(), "func(rows *sql.Rows, err error) ExampleScanner").(*ast.FuncType),
+	}
+
+	ctx, err := genieqltest.GeneratorContext(DialectConfig1())
+	errorsx.MaybePanic(err)
+	limited, err := genieqltest.GeneratorContext(DialectConfig4())
+	errorsx.MaybePanic(err)
+	arrays, err := genieqltest.GeneratorContext(DialectConfig3())
+	errorsx.MaybePanic(err)
+	noreturning, err := genieqltest.GeneratorContext(DialectConfig7())
+	errorsx.MaybePanic(err)
+
+	DescribeTable(
+		"examples",
+		func(in UpdateBatch, out io.Reader) {
+			var (
+				b         = bytes.NewBufferString("package example\n")
+				formatted = bytes.NewBufferString("")
+			)
+
+			Expect(in.Generate(b)).To(Succeed())
+			Expect(astcodec.FormatOutput(formatted, b.Bytes())).To(Succeed())
+			Expect(formatted.String()).To(Equal(testx.IOString(out)))
+		},
+		Entry(
+			"example 1 - batch update",
+			NewUpdateBatch(
+				ctx,
+				"UpdateBatchExample1",
+				nil,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				rowsScanner,
+			).Table("foo").Where("a"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/update.batch/example.1.go"))),
+		),
+		Entry(
+			"example 2 - batch update chunked by the parameter limit",
+			NewUpdateBatch(
+				limited,
+				"UpdateBatchExample2",
+				nil,
+				nil,
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				rowsScanner,
+			).Table("foo").Ignore("b", "a").Where("a"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/update.batch/example.2.go"))),
+		),
+		Entry(
+			"example 3 - batch update binding arrays",
+			NewUpdateBatch(
+				arrays,
+				"UpdateBatchExample3",
+				nil,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				rowsScanner,
+			).Table("foo").Ignore("b").Where("a", "c"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/update.batch/example.3.go"))),
+		),
+	)
+
+	DescribeTable(
+		"errors",
+		func(in UpdateBatch, expected string) {
+			Expect(in.Generate(io.Discard)).To(MatchError(ContainSubstring(expected)))
+		},
+		Entry(
+			"missing predicates",
+			NewUpdateBatch(
+				ctx,
+				"UpdateBatchExample1",
+				nil,
+				nil,
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				rowsScanner,
+			).Table("foo"),
+			"predicate columns are required",
+		),
+		Entry(
+			"unmapped predicate",
+			NewUpdateBatch(
+				ctx,
+				"UpdateBatchExample1",
+				nil,
+				nil,
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				rowsScanner,
+			).Table("foo").Where("z"),
+			"predicate column z is not mapped by the type",
+		),
+		Entry(
+			"arrays of an unknown database type",
+			NewUpdateBatch(
+				arrays,
+				"UpdateBatchExample1",
+				nil,
+				nil,
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructC"), ast.NewIdent("c")),
+				rowsScanner,
+			).Table("foo").Where("a"),
+			"unable to bind column a as an array, unknown database type int",
+		),
+		Entry(
+			"dialect unable to return the updated rows",
+			NewUpdateBatch(
+				noreturning,
+				"UpdateBatchExample1",
+				nil,
+				nil,
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				rowsScanner,
+			).Table("foo").Where("a"),
+			"the dialect is unable to return the modified rows",
+		),
+	)
+})
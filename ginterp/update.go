@@ -0,0 +1,244 @@
+package ginterp
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/generators"
+	"github.com/james-lawrence/genieql/generators/functions"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+)
+
+// Update configuration interface for generating Update.
+type Update interface {
+	genieql.Generator        // must satisfy the generator interface
+	Table(string) Update     // what table to update
+	Ignore(...string) Update // do not attempt to update the specified columns.
+	Where(...string) Update  // columns used to select the rows to update.
+}
+
+func UpdateFromFile(cctx generators.Context, name string, tree *ast.File) (Update, error) {
+	var (
+		ok          bool
+		declPattern *ast.FuncType
+		pos         *ast.FuncDecl
+		scanner     *ast.FuncDecl // scanner to use for the results.
+		cf          *ast.Field
+		qf          *ast.Field
+		tf          *ast.Field
+		params      []*ast.Field
+	)
+
+	if pos = astcodec.FileFindDecl[*ast.FuncDecl](tree, astcodec.FindFunctionsByName(name)); pos == nil {
+		return nil, fmt.Errorf("unable to locate function declaration for update: %s", name)
+	}
+
+	// rewrite scanner declaration function.
+	if declPattern, ok = pos.Type.Params.List[1].Type.(*ast.FuncType); !ok {
+		return nil, errorsx.String("genieql.Update second parameter must be a function type")
+	}
+
+	if scanner = functions.DetectScanner(cctx, declPattern); scanner == nil {
+		return nil, errorsx.Errorf("genieql.Update %s - missing scanner", nodeInfo(cctx, pos))
+	}
+
+	if cf = functions.DetectContext(declPattern); cf != nil {
+		declPattern.Params.List = declPattern.Params.List[1:]
+	}
+
+	if qf = functions.DetectQueryer(declPattern); qf != nil {
+		declPattern.Params.List = declPattern.Params.List[1:]
+	}
+
+	switch plen := len(declPattern.Params.List); plen {
+	case 0:
+		return nil, errorsx.Errorf("genieql.Update %s - missing type to update; should be the last parameter of function declaration argument", nodeInfo(cctx, pos))
+	default:
+		tf = declPattern.Params.List[plen-1]
+		params = declPattern.Params.List
+	}
+
+	return NewUpdate(
+		cctx,
+		pos.Name.String(),
+		pos.Doc,
+		scanner,
+		cf,
+		qf,
+		tf,
+		params...,
+	), nil
+}
+
+// NewUpdate instantiate a new update generator. it uses the name of function
+// that calls Define as the name of the generated function.
+// any parameters preceding the type field are used, in order, as the values
+// for the columns specified by Where. when no such parameters are present the
+// values are read from the type field itself.
+func NewUpdate(
+	ctx generators.Context,
+	name string,
+	comment *ast.CommentGroup,
+	scanner *ast.FuncDecl,
+	cf *ast.Field,
+	qf *ast.Field,
+	tf *ast.Field,
+	params ...*ast.Field,
+) Update {
+	return &update{
+		ctx:     ctx,
+		name:    name,
+		comment: comment,
+		qf:      qf,
+		cf:      cf,
+		tf:      tf,
+		params:  params,
+		scanner: scanner,
+	}
+}
+
+type update struct {
+	ctx     generators.Context
+	name    string
+	table   string
+	ignore  []string
+	where   []string
+	columns genieql.ColumnInfoSet // columns of the table, when specified they restrict the updated and returned columns.
+	params  []*ast.Field
+	tf      *ast.Field    // type field.
+	cf      *ast.Field    // context field, can be nil.
+	qf      *ast.Field    // db Query field.
+	scanner *ast.FuncDecl // scanner being used for results.
+	comment *ast.CommentGroup
+}
+
+// Table specify the table being updated.
+func (t *update) Table(s string) Update {
+	t.table = s
+	return t
+}
+
+// Ignore specify the table columns to leave untouched during the update.
+// ignored columns are still returned by the query.
+func (t *update) Ignore(ignore ...string) Update {
+	t.ignore = ignore
+	return t
+}
+
+// Where specify the table columns used to select the rows to update.
+func (t *update) Where(columns ...string) Update {
+	t.where = columns
+	return t
+}
+
+func (t *update) Generate(dst io.Writer) (err error) {
+	var (
+		updatecmaps []genieql.ColumnMap
+		keycmaps    []genieql.ColumnMap
+		qinputs     []ast.Expr
+		encodings   []ast.Stmt
+		locals      []ast.Spec
+		transforms  []ast.Stmt
+	)
+
+	dialect := t.ctx.Dialect
+
+	t.ctx.Println("generation of", t.name, "initiated")
+	defer t.ctx.Println("generation of", t.name, "completed")
+	t.ctx.Debugln("update type", t.ctx.CurrentPackage.Name, t.ctx.CurrentPackage.ImportPath, types.ExprString(t.tf.Type))
+	t.ctx.Debugln("update table", t.table)
+
+	if strings.TrimSpace(t.table) == "" {
+		return errorsx.Errorf("genieql.Update %s - table is required. use Table method to specify a table", t.name)
+	}
+
+	if err = returning(t.ctx, "genieql.Update", t.name); err != nil {
+		return err
+	}
+
+	if len(t.where) == 0 {
+		return errorsx.Errorf("genieql.Update %s - predicate columns are required. use Where method to specify the columns", t.name)
+	}
+
+	if updatecmaps, err = generators.ColumnMapFromFields(t.ctx, t.tf); err != nil {
+		return errorsx.Wrap(err, "unable to generate mapping")
+	}
+
+	cset := genieql.ColumnMapSet(updatecmaps)
+	projection := cset.ColumnNames()
+	if t.columns != nil {
+		cset = cset.Filter(func(cm genieql.ColumnMap) bool { return slices.Contains(t.columns.ColumnNames(), cm.ColumnInfo.Name) })
+		projection = t.columns.ColumnNames()
+	}
+	// generated columns are computed by the database, as such they're never updated.
+	updatecset := cset.Filter(func(cm genieql.ColumnMap) bool {
+		return genieql.ColumnInfoFilterIgnore(t.ignore...)(cm.ColumnInfo) && genieql.NotGeneratedFilter(cm.ColumnInfo)
+	})
+
+	if keys := t.params[:len(t.params)-1]; len(keys) > 0 {
+		if keycmaps, err = generators.ColumnMapFromFields(t.ctx, keys...); err != nil {
+			return errorsx.Wrap(err, "unable to generate mapping")
+		}
+
+		if len(keycmaps) != len(t.where) {
+			return errorsx.Errorf("genieql.Update %s - number of key parameters (%d) must match the number of Where columns (%d)", t.name, len(keycmaps), len(t.where))
+		}
+	} else {
+		for _, column := range t.where {
+			matches := cset.Filter(func(cm genieql.ColumnMap) bool { return cm.ColumnInfo.Name == column })
+			if len(matches) == 0 {
+				return errorsx.Errorf("genieql.Update %s - predicate column %s is not mapped by %s", t.name, column, types.ExprString(t.tf.Type))
+			}
+			keycmaps = append(keycmaps, matches[0])
+		}
+	}
+
+	if locals, encodings, qinputs, err = generators.QueryInputsFromColumnMap(t.ctx, t.scanner, nil, append(updatecset, keycmaps...)...); err != nil {
+		return errorsx.Wrap(err, "unable to transform query inputs")
+	}
+
+	if len(locals) > 0 {
+		transforms = []ast.Stmt{
+			&ast.DeclStmt{
+				Decl: astutil.VarList(locals...),
+			},
+		}
+	}
+	transforms = append(transforms, encodings...)
+
+	qfn := functions.Query{
+		Context:      t.ctx,
+		Scanner:      t.scanner,
+		Queryer:      t.qf.Type,
+		Transforms:   transforms,
+		QueryInputs:  qinputs,
+		ContextField: t.cf,
+		Query: astutil.StringLiteral(
+			dialect.Update(
+				t.table,
+				updatecset.ColumnNames(),
+				t.where,
+				projection,
+			),
+		),
+	}
+
+	sig := &ast.FuncType{
+		Params: &ast.FieldList{
+			List: astutil.FlattenFields(t.params...),
+		},
+	}
+
+	if err = generators.GenerateComment(generators.DefaultFunctionComment(t.name), t.comment).Generate(dst); err != nil {
+		return err
+	}
+
+	return functions.CompileInto(dst, functions.New(t.name, sig), qfn)
+}
@@ -0,0 +1,121 @@
+package ginterp_test
+
+import (
+	"bytes"
+	"go/ast"
+	"go/token"
+	"io"
+
+	"github.com/james-lawrence/genieql/astcodec"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/genieqltest"
+	. "github.com/james-lawrence/genieql/ginterp"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/membufx"
+	"github.com/james-lawrence/genieql/internal/testx"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Update", func() {
+	rowScanner := &ast.FuncDecl{
+		Name: ast.NewIdent("NewExampleScannerStaticRow"),
+		Type: astutil.MustParseExpr(token.NewFileSet(), "func(row *sql.Row) ExampleRowScanner").(*ast.FuncType),
+	}
+	config := DialectConfig1()
+	ctx, err := genieqltest.GeneratorContext(config)
+	errorsx.MaybePanic(err)
+
+	DescribeTable(
+		"examples",
+		func(in Update, out io.Reader) {
+			var (
+				b         = bytes.NewBufferString("package example\n")
+				formatted = bytes.NewBufferString("")
+			)
+
+			Expect(in.Generate(b)).To(Succeed())
+			Expect(astcodec.FormatOutput(formatted, b.Bytes())).To(Succeed())
+			Expect(formatted.String()).To(Equal(testx.IOString(out)))
+		},
+		Entry(
+			"example 1 - update by key parameter",
+			NewUpdate(
+				ctx,
+				"UpdateExample1",
+				&ast.CommentGroup{
+					List: []*ast.Comment{
+						{Text: "// Basic Update Example"},
+					},
+				},
+				rowScanner,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				astutil.Field(ast.NewIdent("int"), ast.NewIdent("id")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+			).Table("foo").Ignore("a").Where("a"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/update/example.1.go"))),
+		),
+		Entry(
+			"example 2 - update using the fields of the structure",
+			NewUpdate(
+				ctx,
+				"UpdateExample2",
+				nil,
+				rowScanner,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+				astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+			).Table("foo").Ignore("a", "b").Where("a", "b"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/update/example.2.go"))),
+		),
+		Entry(
+			"example 3 - generated columns are never updated",
+			NewUpdate(
+				ctx,
+				"UpdateExample3",
+				nil,
+				rowScanner,
+				astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+				astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+				astutil.Field(ast.NewIdent("StructD"), ast.NewIdent("a")),
+				astutil.Field(ast.NewIdent("StructD"), ast.NewIdent("a")),
+			).Table("struct_d").Where("a"),
+			io.Reader(membufx.NewMemBuffer(testx.Fixture(".fixtures/update/example.3.go"))),
+		),
+	)
+
+	It("should require predicate columns", func() {
+		gen := NewUpdate(
+			ctx,
+			"UpdateExample3",
+			nil,
+			rowScanner,
+			astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+			astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+			astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+			astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+		).Table("foo")
+		Expect(gen.Generate(io.Discard)).ToNot(Succeed())
+	})
+
+	It("should require a dialect returning the updated rows", func() {
+		noreturning, err := genieqltest.GeneratorContext(DialectConfig7())
+		Expect(err).To(Succeed())
+
+		gen := NewUpdate(
+			noreturning,
+			"UpdateExample1",
+			nil,
+			rowScanner,
+			astutil.Field(astutil.Expr("context.Context"), ast.NewIdent("ctx")),
+			astutil.Field(astutil.Expr("sqlx.Queryer"), ast.NewIdent("q")),
+			astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+			astutil.Field(ast.NewIdent("StructA"), ast.NewIdent("a")),
+		).Table("foo").Where("a")
+		Expect(gen.Generate(io.Discard)).To(MatchError(ContainSubstring("the dialect is unable to return the modified rows")))
+	})
+})
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE example RENAME COLUMN name TO display_name;
+
+-- +goose Down
+ALTER TABLE example RENAME COLUMN display_name TO name;
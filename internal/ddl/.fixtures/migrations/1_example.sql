@@ -0,0 +1,9 @@
+-- +goose Up
+CREATE TABLE example (
+	id bigserial PRIMARY KEY,
+	email varchar(255) NOT NULL,
+	name text
+);
+
+-- +goose Down
+DROP TABLE example;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE example ADD COLUMN created_at timestamptz NOT NULL DEFAULT now();
+ALTER TABLE example ALTER COLUMN name SET NOT NULL;
+
+-- +goose Down
+ALTER TABLE example DROP COLUMN created_at;
+ALTER TABLE example ALTER COLUMN name DROP NOT NULL;
@@ -0,0 +1,13 @@
+package ddl_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestDDL(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "DDL Suite")
+}
@@ -0,0 +1,135 @@
+package ddl_test
+
+import (
+	"strings"
+
+	"github.com/james-lawrence/genieql/dialects"
+	. "github.com/james-lawrence/genieql/internal/ddl"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ddl", func() {
+	DescribeTable("Read",
+		func(src string, expected ...Table) {
+			s, err := Read(strings.NewReader(src))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.Tables).To(Equal(expected))
+		},
+		Entry(
+			"example 1",
+			"CREATE TABLE example (id integer NOT NULL PRIMARY KEY, name varchar(255), created_at timestamp with time zone DEFAULT now() NOT NULL)",
+			Table{Name: "example", Columns: []Column{
+				{Name: "id", Type: "integer", NotNull: true, PrimaryKey: 1},
+				{Name: "name", Type: "varchar(255)"},
+				{Name: "created_at", Type: "timestamp with time zone", NotNull: true, Default: "now()"},
+			}},
+		),
+		Entry(
+			"composite primary keys are ordered by their position within the key",
+			"CREATE TABLE IF NOT EXISTS public.example (a text, b numeric(10, 2), CONSTRAINT example_pkey PRIMARY KEY (b, a))",
+			Table{Name: "example", Columns: []Column{
+				{Name: "a", Type: "text", PrimaryKey: 2},
+				{Name: "b", Type: "numeric(10,2)", PrimaryKey: 1},
+			}},
+		),
+		Entry(
+			"quoted identifiers, comments and statements that aren't tables",
+			`-- the example table.
+			CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+			/* multi-line
+			comment; */
+			CREATE TABLE "Example" ("ID" uuid DEFAULT uuid_generate_v4() PRIMARY KEY, "select" text DEFAULT 'a;b' NOT NULL);
+			CREATE INDEX example_select ON "Example" ("select");
+			CREATE FUNCTION noop() RETURNS void AS $$ BEGIN CREATE TABLE ignored (id int); END; $$ LANGUAGE plpgsql;`,
+			Table{Name: "Example", Columns: []Column{
+				{Name: "ID", Type: "uuid", PrimaryKey: 1, Default: "uuid_generate_v4()"},
+				{Name: "select", Type: "text", NotNull: true, Default: "'a;b'"},
+			}},
+		),
+		Entry(
+			"serial and identity columns are defaulted",
+			"CREATE TABLE example (a serial, b bigint GENERATED BY DEFAULT AS IDENTITY, c integer GENERATED ALWAYS AS (a + 1) STORED)",
+			Table{Name: "example", Columns: []Column{
+				{Name: "a", Type: "serial", NotNull: true, Default: "serial"},
+				{Name: "b", Type: "bigint", NotNull: true, Default: "GENERATED BY DEFAULT AS IDENTITY"},
+				{Name: "c", Type: "integer", Generated: true},
+			}},
+		),
+		Entry(
+			"mysql column definitions",
+			"CREATE TABLE `example` (`id` int(10) unsigned NOT NULL AUTO_INCREMENT, `name` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL COMMENT 'the name', `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP, PRIMARY KEY (`id`), KEY `example_name` (`name`)) ENGINE=InnoDB",
+			Table{Name: "example", Columns: []Column{
+				{Name: "id", Type: "int(10) unsigned", NotNull: true, PrimaryKey: 1, Default: "AUTO_INCREMENT"},
+				{Name: "name", Type: "varchar(64)", NotNull: true},
+				{Name: "updated_at", Type: "datetime", NotNull: true, Default: "CURRENT_TIMESTAMP"},
+			}},
+		),
+		Entry(
+			"foreign keys and checks are ignored",
+			"CREATE TABLE example (id int PRIMARY KEY, parent int REFERENCES example (id) ON DELETE SET NULL, score int CHECK (score > 0), UNIQUE (score), FOREIGN KEY (parent) REFERENCES example (id))",
+			Table{Name: "example", Columns: []Column{
+				{Name: "id", Type: "int", PrimaryKey: 1},
+				{Name: "parent", Type: "int"},
+				{Name: "score", Type: "int"},
+			}},
+		),
+		Entry(
+			"alter table",
+			`CREATE TABLE example (id int, a text, b text, c text);
+			ALTER TABLE example ADD PRIMARY KEY (id), ADD COLUMN d int[] NOT NULL, DROP COLUMN b;
+			ALTER TABLE example ALTER COLUMN a SET NOT NULL, ALTER COLUMN a SET DEFAULT 'x', ALTER c TYPE varchar(10) USING c::varchar(10);
+			ALTER TABLE example RENAME COLUMN c TO e;`,
+			Table{Name: "example", Columns: []Column{
+				{Name: "id", Type: "int", PrimaryKey: 1},
+				{Name: "a", Type: "text", NotNull: true, Default: "'x'"},
+				{Name: "e", Type: "varchar(10)"},
+				{Name: "d", Type: "int[]", NotNull: true},
+			}},
+		),
+		Entry(
+			"mysql alter table",
+			"CREATE TABLE example (id int PRIMARY KEY, a text, b text); ALTER TABLE example MODIFY COLUMN a varchar(10) NOT NULL, CHANGE b c int, RENAME TO example2",
+			Table{Name: "example2", Columns: []Column{
+				{Name: "id", Type: "int", PrimaryKey: 1},
+				{Name: "a", Type: "varchar(10)", NotNull: true},
+				{Name: "c", Type: "int"},
+			}},
+		),
+		Entry(
+			"dropped tables",
+			"CREATE TABLE a (id int); CREATE TABLE b (id int); DROP TABLE IF EXISTS a, c",
+			Table{Name: "b", Columns: []Column{{Name: "id", Type: "int"}}},
+		),
+	)
+
+	It("should fold unquoted identifiers", func() {
+		s, err := Read(strings.NewReader(`CREATE TABLE Example (ID int, "Name" text)`), OptionFold(strings.ToLower))
+		Expect(err).ToNot(HaveOccurred())
+		t, ok := s.Lookup("public.example")
+		Expect(ok).To(BeTrue())
+		Expect(t.Columns).To(Equal([]Column{{Name: "id", Type: "int"}, {Name: "Name", Type: "text"}}))
+	})
+
+	It("should reject unterminated strings", func() {
+		_, err := Read(strings.NewReader("CREATE TABLE example (a text DEFAULT 'b)"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should apply the up migrations of a directory in version order", func() {
+		s, err := ReadDir(".fixtures/migrations")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.Tables).To(Equal([]Table{{Name: "example", Columns: []Column{
+			{Name: "id", Type: "bigserial", NotNull: true, PrimaryKey: 1, Default: "bigserial"},
+			{Name: "email", Type: "varchar(255)", NotNull: true},
+			{Name: "display_name", Type: "text", NotNull: true},
+			{Name: "created_at", Type: "timestamptz", NotNull: true, Default: "now()"},
+		}}}))
+	})
+
+	It("should reject validating queries without a database", func() {
+		d := NewDialect(dialects.Test{}, Schema{}, nil, nil)
+		Expect(d.Validate("SELECT 1")).To(MatchError(ContainSubstring("a database connection is required")))
+	})
+})
@@ -0,0 +1,90 @@
+package ddl
+
+import (
+	"log"
+	"slices"
+
+	"github.com/davecgh/go-spew/spew"
+
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/internal/debugx"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+)
+
+// LookupType resolves the declared type of a column using the driver.
+type LookupType func(d genieql.Driver, declared string) (genieql.ColumnDefinition, error)
+
+// NewDialect describes the tables using the schema, queries are generated by the dialect.
+// the columns of a table are ordered by the comparison, matching the dialect's own ordering.
+func NewDialect(d genieql.Dialect, s Schema, lookup LookupType, order func(a, b Column) int) genieql.Dialect {
+	return dialect{Dialect: d, schema: s, lookup: lookup, order: order}
+}
+
+type dialect struct {
+	genieql.Dialect
+	schema Schema
+	lookup LookupType
+	order  func(a, b Column) int
+}
+
+func (t dialect) ColumnInformationForTable(d genieql.Driver, table string) ([]genieql.ColumnInfo, error) {
+	var (
+		err     error
+		columns []genieql.ColumnInfo
+	)
+
+	tb, ok := t.schema.Lookup(table)
+	if !ok {
+		return nil, errorsx.Errorf("table %s is not created by the migrations", table)
+	}
+
+	found := slices.Clone(tb.Columns)
+	slices.SortStableFunc(found, t.order)
+
+	for _, c := range found {
+		var (
+			columndef genieql.ColumnDefinition
+		)
+
+		if columndef, err = t.lookup(d, c.Type); err != nil {
+			log.Println("skipping column", c.Name, "driver missing type", c.Type, "please open an issue")
+			continue
+		}
+
+		switch columndef.Native {
+		case "[]byte":
+			columndef.Nullable = false
+		default:
+			columndef.Nullable = !c.NotNull && c.PrimaryKey == 0
+		}
+
+		columndef.PrimaryKey = c.PrimaryKey > 0
+		columndef.Default = c.Default
+		// generated columns can not be written, they're only available to queries.
+		columndef.Generated = c.Generated
+
+		debugx.Println("found column", c.Name, c.Type, spew.Sdump(columndef))
+
+		columns = append(columns, genieql.ColumnInfo{
+			Name:       c.Name,
+			Definition: columndef,
+		})
+	}
+
+	return columns, nil
+}
+
+// ColumnInformationForQuery describing the results of a query requires the database to plan it.
+func (t dialect) ColumnInformationForQuery(d genieql.Driver, query string) ([]genieql.ColumnInfo, error) {
+	return nil, errorsx.Errorf("unable to describe the columns of a query from migrations, a database connection is required: %s", query)
+}
+
+// ParameterInformationForQuery no information is available without a database.
+func (t dialect) ParameterInformationForQuery(d genieql.Driver, query string) ([]genieql.ColumnInfo, error) {
+	return []genieql.ColumnInfo(nil), nil
+}
+
+// Validate queries can't be prepared without a database.
+func (t dialect) Validate(query string) error {
+	return errorsx.Errorf("unable to validate queries from migrations, a database connection is required: %s", query)
+}
@@ -0,0 +1,183 @@
+package ddl
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/james-lawrence/genieql/internal/errorsx"
+)
+
+type tokenKind int
+
+const (
+	tokenWord   tokenKind = iota // unquoted identifiers and keywords.
+	tokenQuoted                  // quoted identifiers. i.e.) "a", `a`
+	tokenString                  // string literals. i.e.) 'a', $$a$$
+	tokenNumber
+	tokenPunct
+)
+
+type token struct {
+	kind  tokenKind
+	text  string // source text of the token.
+	ident string // identifier without quotes.
+}
+
+// keyword reports if the token is the unquoted keyword, case insensitive.
+func (t token) keyword(kws ...string) bool {
+	if t.kind != tokenWord {
+		return false
+	}
+
+	for _, kw := range kws {
+		if strings.EqualFold(t.text, kw) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t token) punct(p string) bool {
+	return t.kind == tokenPunct && t.text == p
+}
+
+// lex the source into statements of tokens, comments are discarded.
+func lex(src string) (stmts [][]token, err error) {
+	var (
+		current []token
+		r       = []rune(src)
+	)
+
+	for i := 0; i < len(r); {
+		c := r[i]
+
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '-' && peek(r, i+1) == '-':
+			for i < len(r) && r[i] != '\n' {
+				i++
+			}
+		case c == '/' && peek(r, i+1) == '*':
+			end := index(r, i+2, []rune("*/"))
+			if end < 0 {
+				return nil, errorsx.String("unterminated comment")
+			}
+			i = end + 2
+		case c == ';':
+			if len(current) > 0 {
+				stmts = append(stmts, current)
+			}
+			current = nil
+			i++
+		case c == '\'':
+			j, cause := closing(r, i, '\'')
+			if cause != nil {
+				return nil, cause
+			}
+			current = append(current, token{kind: tokenString, text: string(r[i:j])})
+			i = j
+		case c == '"' || c == '`':
+			j, cause := closing(r, i, c)
+			if cause != nil {
+				return nil, cause
+			}
+			quoted := string(r[i+1 : j-1])
+			current = append(current, token{kind: tokenQuoted, text: string(r[i:j]), ident: strings.ReplaceAll(quoted, string([]rune{c, c}), string(c))})
+			i = j
+		case c == '$' && len(dollarTag(r, i)) > 0:
+			tag := dollarTag(r, i)
+			end := index(r, i+len(tag), tag)
+			if end < 0 {
+				return nil, errorsx.Errorf("unterminated dollar quoted string %s", string(tag))
+			}
+			j := end + len(tag)
+			current = append(current, token{kind: tokenString, text: string(r[i:j])})
+			i = j
+		case unicode.IsDigit(c) || (c == '.' && unicode.IsDigit(peek(r, i+1))):
+			j := i
+			for j < len(r) && (unicode.IsDigit(r[j]) || r[j] == '.' || r[j] == 'e' || r[j] == 'E') {
+				j++
+			}
+			current = append(current, token{kind: tokenNumber, text: string(r[i:j])})
+			i = j
+		case isWord(c):
+			j := i
+			for j < len(r) && (isWord(r[j]) || unicode.IsDigit(r[j]) || r[j] == '$') {
+				j++
+			}
+			current = append(current, token{kind: tokenWord, text: string(r[i:j]), ident: string(r[i:j])})
+			i = j
+		case c == ':' && peek(r, i+1) == ':':
+			current = append(current, token{kind: tokenPunct, text: "::"})
+			i += 2
+		default:
+			current = append(current, token{kind: tokenPunct, text: string(c)})
+			i++
+		}
+	}
+
+	if len(current) > 0 {
+		stmts = append(stmts, current)
+	}
+
+	return stmts, nil
+}
+
+func peek(r []rune, i int) rune {
+	if i < len(r) {
+		return r[i]
+	}
+
+	return 0
+}
+
+func isWord(c rune) bool {
+	return unicode.IsLetter(c) || c == '_'
+}
+
+// closing returns the offset following the closing quote, doubled quotes are escapes.
+func closing(r []rune, i int, quote rune) (int, error) {
+	for j := i + 1; j < len(r); j++ {
+		if r[j] != quote {
+			continue
+		}
+
+		if peek(r, j+1) == quote {
+			j++
+			continue
+		}
+
+		return j + 1, nil
+	}
+
+	return 0, errorsx.Errorf("unterminated quote %c", quote)
+}
+
+// index of the sequence within r starting at the offset, -1 when missing.
+func index(r []rune, offset int, seq []rune) int {
+	for i := offset; i+len(seq) <= len(r); i++ {
+		if slices.Equal(r[i:i+len(seq)], seq) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// dollarTag the opening tag of a postgresql dollar quoted string. i.e.) $$ or $body$
+func dollarTag(r []rune, i int) []rune {
+	for j := i + 1; j < len(r); j++ {
+		switch {
+		case r[j] == '$':
+			return r[i : j+1]
+		case isWord(r[j]) || (j > i+1 && unicode.IsDigit(r[j])):
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
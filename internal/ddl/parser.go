@@ -0,0 +1,647 @@
+package ddl
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/james-lawrence/genieql/internal/debugx"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+)
+
+// Option customizes how DDL statements are interpreted.
+type Option func(*parser)
+
+// OptionFold transforms unquoted identifiers. i.e.) postgresql folds them to lower case.
+func OptionFold(fn func(string) string) Option {
+	return func(p *parser) {
+		p.fold = fn
+	}
+}
+
+// Read the DDL statements of the reader into a schema.
+func Read(r io.Reader, options ...Option) (Schema, error) {
+	p := newParser(options...)
+
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return Schema{}, errorsx.Wrap(err, "unable to read ddl")
+	}
+
+	if err = p.parse(string(raw)); err != nil {
+		return Schema{}, err
+	}
+
+	return p.schema, nil
+}
+
+// ReadDir applies the .sql files of the directory in migration order, numbered migrations
+// are ordered by their version and the remaining files by name. an empty directory name
+// describes no tables.
+func ReadDir(dir string, options ...Option) (Schema, error) {
+	p := newParser(options...)
+
+	if dir == "" {
+		return p.schema, nil
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return Schema{}, errorsx.Wrapf(err, "unable to list migrations: %s", dir)
+	}
+
+	slices.SortFunc(paths, migrationOrder)
+
+	for _, path := range paths {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return Schema{}, errorsx.Wrapf(err, "unable to read migration: %s", path)
+		}
+
+		if err = p.parse(string(raw)); err != nil {
+			return Schema{}, errorsx.Wrapf(err, "invalid migration: %s", path)
+		}
+	}
+
+	return p.schema, nil
+}
+
+// migrationOrder orders paths by their version prefix. i.e.) 2_b.sql before 10_a.sql
+func migrationOrder(a, b string) int {
+	version := func(path string) (uint64, bool) {
+		name := filepath.Base(path)
+		end := strings.IndexFunc(name, func(r rune) bool { return r < '0' || r > '9' })
+		if end <= 0 {
+			return 0, false
+		}
+
+		v, err := strconv.ParseUint(name[:end], 10, 64)
+		return v, err == nil
+	}
+
+	va, oka := version(a)
+	vb, okb := version(b)
+	if oka && okb && va != vb {
+		if va < vb {
+			return -1
+		}
+		return 1
+	}
+
+	return strings.Compare(filepath.Base(a), filepath.Base(b))
+}
+
+// up the statements applied by a migration, goose migrations are limited to their up section.
+func up(src string) string {
+	if !strings.Contains(src, "-- +goose") {
+		return src
+	}
+
+	var (
+		b       strings.Builder
+		include bool
+	)
+
+	for _, line := range strings.SplitAfter(src, "\n") {
+		switch trimmed := strings.TrimSpace(line); {
+		case strings.HasPrefix(trimmed, "-- +goose Up"):
+			include = true
+			continue
+		case strings.HasPrefix(trimmed, "-- +goose Down"):
+			include = false
+			continue
+		}
+
+		if include {
+			b.WriteString(line)
+		}
+	}
+
+	return b.String()
+}
+
+func newParser(options ...Option) *parser {
+	p := &parser{
+		fold: func(s string) string { return s },
+	}
+
+	for _, opt := range options {
+		opt(p)
+	}
+
+	return p
+}
+
+type parser struct {
+	fold   func(string) string
+	schema Schema
+}
+
+func (t *parser) parse(src string) error {
+	stmts, err := lex(up(src))
+	if err != nil {
+		return err
+	}
+
+	for _, stmt := range stmts {
+		if err = t.statement(&cursor{tokens: stmt}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// statement applies the table statements to the schema, everything else is ignored.
+func (t *parser) statement(c *cursor) error {
+	switch {
+	case c.accept("CREATE"):
+		for c.peek().keyword("GLOBAL", "LOCAL", "TEMP", "TEMPORARY", "UNLOGGED") {
+			c.next()
+		}
+
+		if !c.accept("TABLE") {
+			return nil
+		}
+
+		return t.create(c)
+	case c.accept("ALTER", "TABLE"):
+		return t.alter(c)
+	case c.accept("DROP", "TABLE"):
+		return t.drop(c)
+	default:
+		return nil
+	}
+}
+
+func (t *parser) create(c *cursor) error {
+	ifnotexists := c.accept("IF", "NOT", "EXISTS")
+	name := t.name(c)
+	if name == "" {
+		return errorsx.String("create table is missing the table name")
+	}
+
+	// CREATE TABLE ... AS SELECT and friends can't be described without a database.
+	if !c.peek().punct("(") {
+		debugx.Println("ignoring table without column definitions", name)
+		return nil
+	}
+
+	idx := t.schema.index(name)
+	if idx >= 0 && ifnotexists {
+		return nil
+	}
+
+	table := Table{Name: name}
+	for _, element := range split(c.group()) {
+		ec := &cursor{tokens: element}
+
+		if constraint(ec) {
+			t.constraint(&table, ec)
+			continue
+		}
+
+		column, err := t.column(ec)
+		if err != nil {
+			return errorsx.Wrapf(err, "table %s", name)
+		}
+
+		table.Columns = append(table.Columns, column)
+	}
+
+	if idx >= 0 {
+		t.schema.Tables[idx] = table
+		return nil
+	}
+
+	t.schema.Tables = append(t.schema.Tables, table)
+	return nil
+}
+
+func (t *parser) alter(c *cursor) error {
+	c.accept("IF", "EXISTS")
+	c.accept("ONLY")
+
+	name := t.name(c)
+	idx := t.schema.index(name)
+	if idx < 0 {
+		debugx.Println("ignoring alter of unknown table", name)
+		return nil
+	}
+
+	table := &t.schema.Tables[idx]
+
+	for _, action := range split(c.remaining()) {
+		if err := t.action(table, &cursor{tokens: action}); err != nil {
+			return errorsx.Wrapf(err, "alter table %s", name)
+		}
+	}
+
+	return nil
+}
+
+func (t *parser) action(table *Table, c *cursor) error {
+	switch {
+	case c.accept("ADD"):
+		if constraint(c) {
+			t.constraint(table, c)
+			return nil
+		}
+
+		c.accept("COLUMN")
+		ifnotexists := c.accept("IF", "NOT", "EXISTS")
+
+		column, err := t.column(c)
+		if err != nil {
+			return err
+		}
+
+		if existing := table.column(column.Name); existing != nil {
+			if !ifnotexists {
+				*existing = column
+			}
+			return nil
+		}
+
+		table.Columns = append(table.Columns, column)
+	case c.accept("DROP", "PRIMARY", "KEY"):
+		for i := range table.Columns {
+			table.Columns[i].PrimaryKey = 0
+		}
+	case c.accept("DROP", "CONSTRAINT"):
+		// constraint names aren't tracked, there is no way to know if the primary key was dropped.
+	case c.accept("DROP"):
+		c.accept("COLUMN")
+		c.accept("IF", "EXISTS")
+		name := t.ident(c.next())
+		table.Columns = slices.DeleteFunc(table.Columns, func(col Column) bool { return strings.EqualFold(col.Name, name) })
+	case c.accept("ALTER"):
+		c.accept("COLUMN")
+		column := table.column(t.ident(c.next()))
+		if column == nil {
+			return nil
+		}
+
+		switch {
+		case c.accept("SET", "NOT", "NULL"):
+			column.NotNull = true
+		case c.accept("DROP", "NOT", "NULL"):
+			column.NotNull = false
+		case c.accept("SET", "DEFAULT"):
+			column.Default = text(c.expression())
+		case c.accept("DROP", "DEFAULT"):
+			column.Default = ""
+		case c.accept("SET", "DATA", "TYPE"), c.accept("TYPE"):
+			column.Type = text(c.until(func(c *cursor) bool { return c.peek().keyword("USING", "COLLATE") }))
+		}
+	case c.accept("MODIFY"):
+		c.accept("COLUMN")
+		return t.replace(table, c, "")
+	case c.accept("CHANGE"):
+		c.accept("COLUMN")
+		return t.replace(table, c, t.ident(c.next()))
+	case c.accept("RENAME", "TO"), c.accept("RENAME", "AS"):
+		table.Name = t.name(c)
+	case c.accept("RENAME"):
+		c.accept("COLUMN")
+		column := table.column(t.ident(c.next()))
+		c.accept("TO")
+		if column != nil {
+			column.Name = t.ident(c.next())
+		}
+	}
+
+	return nil
+}
+
+// replace redefines a column, the primary key is an index of the table and survives the redefinition.
+func (t *parser) replace(table *Table, c *cursor, previous string) error {
+	column, err := t.column(c)
+	if err != nil {
+		return err
+	}
+
+	if previous == "" {
+		previous = column.Name
+	}
+
+	existing := table.column(previous)
+	if existing == nil {
+		return nil
+	}
+
+	column.PrimaryKey = max(column.PrimaryKey, existing.PrimaryKey)
+	*existing = column
+	return nil
+}
+
+func (t *parser) drop(c *cursor) error {
+	c.accept("IF", "EXISTS")
+
+	for {
+		name := t.name(c)
+		if idx := t.schema.index(name); idx >= 0 {
+			t.schema.Tables = slices.Delete(t.schema.Tables, idx, idx+1)
+		}
+
+		if !c.peek().punct(",") {
+			return nil
+		}
+		c.next()
+	}
+}
+
+// column parses a column definition. i.e.) id bigint NOT NULL PRIMARY KEY
+func (t *parser) column(c *cursor) (column Column, err error) {
+	if c.done() {
+		return column, errorsx.String("empty column definition")
+	}
+
+	column.Name = t.ident(c.next())
+	column.Type = text(c.until(constraintStart))
+
+	// serial types are shorthand for an integer defaulted by a sequence.
+	switch strings.ToLower(column.Type) {
+	case "serial", "serial4", "bigserial", "serial8", "smallserial", "serial2":
+		column.NotNull = true
+		column.Default = column.Type
+	}
+
+	for !c.done() {
+		start := c.pos
+
+		switch {
+		case c.accept("CONSTRAINT"):
+			c.next()
+		case c.accept("NOT", "NULL"):
+			column.NotNull = true
+		case c.accept("NULL"):
+			column.NotNull = false
+		case c.accept("PRIMARY", "KEY"):
+			column.PrimaryKey = 1
+		case c.accept("DEFAULT"):
+			column.Default = text(c.expression())
+		case c.accept("GENERATED"):
+			if !c.accept("ALWAYS") {
+				c.accept("BY", "DEFAULT")
+			}
+			c.accept("AS")
+
+			// identity columns are implicitly NOT NULL.
+			if c.accept("IDENTITY") {
+				c.skip()
+				column.NotNull = true
+				column.Default = text(c.tokens[start:c.pos])
+				continue
+			}
+
+			c.skip()
+			column.Generated = true
+		case c.accept("AS"):
+			c.skip()
+			column.Generated = true
+		case c.accept("AUTO_INCREMENT"), c.accept("AUTOINCREMENT"), c.accept("IDENTITY"):
+			c.skip()
+			column.Default = text(c.tokens[start:c.pos])
+		case c.accept("REFERENCES"):
+			t.name(c)
+			c.skip()
+		case c.accept("ON"):
+			// referential actions and mysql's ON UPDATE CURRENT_TIMESTAMP.
+			c.next()
+			if !c.accept("SET") && !c.accept("NO") {
+				c.expression()
+				continue
+			}
+			c.next()
+		case c.accept("CHECK"):
+			c.skip()
+		case c.accept("COLLATE"), c.accept("COMMENT"), c.accept("CHARSET"), c.accept("CHARACTER", "SET"):
+			c.next()
+		default:
+			c.skip()
+			if c.pos == start {
+				c.next()
+			}
+		}
+	}
+
+	return column, nil
+}
+
+// constraint applies a table constraint, only primary keys are relevant.
+func (t *parser) constraint(table *Table, c *cursor) {
+	if c.accept("CONSTRAINT") {
+		c.next()
+	}
+
+	if !c.accept("PRIMARY", "KEY") {
+		return
+	}
+
+	for i := range table.Columns {
+		table.Columns[i].PrimaryKey = 0
+	}
+
+	for pos, element := range split(c.group()) {
+		if len(element) == 0 {
+			continue
+		}
+
+		if column := table.column(t.ident(element[0])); column != nil {
+			column.PrimaryKey = pos + 1
+		}
+	}
+}
+
+// name of a possibly schema qualified object, the qualifiers are discarded.
+func (t *parser) name(c *cursor) (name string) {
+	name = t.ident(c.next())
+	for c.peek().punct(".") {
+		c.next()
+		name = t.ident(c.next())
+	}
+
+	return name
+}
+
+func (t *parser) ident(tok token) string {
+	if tok.kind == tokenWord {
+		return t.fold(tok.ident)
+	}
+
+	return tok.ident
+}
+
+// constraint reports if the element is a table constraint rather than a column.
+func constraint(c *cursor) bool {
+	return c.peek().keyword("CONSTRAINT", "PRIMARY", "UNIQUE", "FOREIGN", "CHECK", "KEY", "INDEX", "EXCLUDE", "FULLTEXT", "SPATIAL")
+}
+
+// constraintStart reports if the cursor is at the start of a column constraint.
+func constraintStart(c *cursor) bool {
+	return c.peek().keyword(
+		"CONSTRAINT", "NOT", "NULL", "PRIMARY", "DEFAULT", "UNIQUE", "REFERENCES", "CHECK",
+		"GENERATED", "AUTO_INCREMENT", "AUTOINCREMENT", "IDENTITY", "COLLATE", "AS", "COMMENT", "ON", "CHARSET",
+	) || c.peeks("CHARACTER", "SET")
+}
+
+// split the tokens on commas outside of parentheses.
+func split(tokens []token) (elements [][]token) {
+	var (
+		depth int
+		start int
+	)
+
+	for i, tok := range tokens {
+		switch {
+		case tok.punct("("):
+			depth++
+		case tok.punct(")"):
+			depth--
+		case tok.punct(",") && depth == 0:
+			elements = append(elements, tokens[start:i])
+			start = i + 1
+		}
+	}
+
+	if start < len(tokens) {
+		elements = append(elements, tokens[start:])
+	}
+
+	return elements
+}
+
+// text joins the tokens back into sql. words are separated by spaces, punctuation is attached.
+// i.e.) varchar(255), character varying, numeric(10,2), int[]
+func text(tokens []token) string {
+	var b strings.Builder
+	for i, tok := range tokens {
+		if i > 0 && tok.kind != tokenPunct && !tokens[i-1].punct("(") && !tokens[i-1].punct(",") && !tokens[i-1].punct("::") && !tokens[i-1].punct(".") {
+			b.WriteString(" ")
+		}
+		b.WriteString(tok.text)
+	}
+
+	return b.String()
+}
+
+type cursor struct {
+	tokens []token
+	pos    int
+}
+
+func (t *cursor) done() bool {
+	return t.pos >= len(t.tokens)
+}
+
+func (t *cursor) peek() token {
+	if t.done() {
+		return token{kind: tokenPunct}
+	}
+
+	return t.tokens[t.pos]
+}
+
+func (t *cursor) next() token {
+	tok := t.peek()
+	if !t.done() {
+		t.pos++
+	}
+
+	return tok
+}
+
+// peeks reports if the upcoming tokens are the keywords.
+func (t *cursor) peeks(kws ...string) bool {
+	if t.pos+len(kws) > len(t.tokens) {
+		return false
+	}
+
+	for i, kw := range kws {
+		if !t.tokens[t.pos+i].keyword(kw) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// accept consumes the keywords when all of them are the upcoming tokens.
+func (t *cursor) accept(kws ...string) bool {
+	if !t.peeks(kws...) {
+		return false
+	}
+
+	t.pos += len(kws)
+	return true
+}
+
+func (t *cursor) remaining() []token {
+	tokens := t.tokens[t.pos:]
+	t.pos = len(t.tokens)
+	return tokens
+}
+
+// group consumes a parenthesized group returning its contents, nil when the cursor isn't at a group.
+func (t *cursor) group() []token {
+	if !t.peek().punct("(") {
+		return nil
+	}
+
+	start := t.pos
+	t.skip()
+	return t.tokens[start+1 : t.pos-1]
+}
+
+// skip consumes a parenthesized group including any nested groups.
+func (t *cursor) skip() {
+	if !t.peek().punct("(") {
+		return
+	}
+
+	depth := 0
+	for !t.done() {
+		switch tok := t.next(); {
+		case tok.punct("("):
+			depth++
+		case tok.punct(")"):
+			depth--
+		}
+
+		if depth == 0 {
+			return
+		}
+	}
+}
+
+// until consumes tokens and groups until the stop condition is met.
+func (t *cursor) until(stop func(*cursor) bool) []token {
+	start := t.pos
+	for !t.done() && !stop(t) {
+		if t.peek().punct("(") {
+			t.skip()
+			continue
+		}
+		t.next()
+	}
+
+	return t.tokens[start:t.pos]
+}
+
+// expression consumes a default expression, the first token is always part of the expression.
+// i.e.) NULL, now(), 'a' || 'b', (1 + 2)
+func (t *cursor) expression() []token {
+	start := t.pos
+	if t.peek().punct("(") {
+		t.skip()
+	} else {
+		t.next()
+	}
+
+	t.until(constraintStart)
+	return t.tokens[start:t.pos]
+}
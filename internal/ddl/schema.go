@@ -0,0 +1,83 @@
+// Package ddl describes the tables of a database from the DDL statements of its migrations,
+// allowing generation without a database connection.
+package ddl
+
+import (
+	"slices"
+	"strings"
+)
+
+// Schema the tables described by DDL statements.
+type Schema struct {
+	Tables []Table
+}
+
+// Lookup the table by name. schema qualified names match the unqualified table
+// and tables are matched case insensitively when there is no exact match.
+func (t Schema) Lookup(name string) (Table, bool) {
+	if idx := t.index(unqualified(name)); idx >= 0 {
+		return t.Tables[idx], true
+	}
+
+	return Table{}, false
+}
+
+func (t Schema) index(name string) int {
+	if idx := slices.IndexFunc(t.Tables, func(tb Table) bool { return tb.Name == name }); idx >= 0 {
+		return idx
+	}
+
+	return slices.IndexFunc(t.Tables, func(tb Table) bool { return strings.EqualFold(tb.Name, name) })
+}
+
+// Table the columns of a table in declaration order.
+type Table struct {
+	Name    string
+	Columns []Column
+}
+
+func (t *Table) column(name string) *Column {
+	if idx := slices.IndexFunc(t.Columns, func(c Column) bool { return strings.EqualFold(c.Name, name) }); idx >= 0 {
+		return &t.Columns[idx]
+	}
+
+	return nil
+}
+
+// Column the definition of a column.
+type Column struct {
+	Name       string
+	Type       string // declared type. i.e.) varchar(255)
+	NotNull    bool
+	PrimaryKey int    // 1-based position within the primary key, zero when not part of the key.
+	Default    string // clause providing the value when an insert omits the column. i.e.) now(), AUTO_INCREMENT
+	Generated  bool   // computed by the database, the column can not be written.
+}
+
+// ByName orders the columns by name.
+func ByName(a, b Column) int {
+	return strings.Compare(a.Name, b.Name)
+}
+
+// KeysFirst orders the primary key columns by their position within the key
+// followed by the remaining columns ordered by name.
+func KeysFirst(a, b Column) int {
+	switch {
+	case a.PrimaryKey > 0 && b.PrimaryKey > 0:
+		return a.PrimaryKey - b.PrimaryKey
+	case a.PrimaryKey > 0:
+		return -1
+	case b.PrimaryKey > 0:
+		return 1
+	default:
+		return ByName(a, b)
+	}
+}
+
+func unqualified(name string) string {
+	if idx := strings.LastIndex(name, "."); idx >= 0 {
+		return name[idx+1:]
+	}
+
+	return name
+}
@@ -0,0 +1,267 @@
+package drivers
+
+import (
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+)
+
+const MySQL = "github.com/go-sql-driver/mysql"
+
+// implements the mysql driver https://github.com/go-sql-driver/mysql
+// the types are the upper cased DATA_TYPE of information_schema.COLUMNS, unsigned
+// integers are widened by the dialect except for BIGINT UNSIGNED.
+// times require the parseTime option of the dsn, which the dialect always enables.
+func init() {
+	errorsx.MaybePanic(genieql.RegisterDriver(MySQL, NewDriver(MySQL, mysql...)))
+}
+
+var mysql = []genieql.ColumnDefinition{
+	{
+		DBTypeName: "TINYINT",
+		Type:       "TINYINT",
+		ColumnType: "sql.NullInt16",
+		Native:     int16ExprString,
+		Decode:     StdlibDecodeInt16,
+		Encode:     StdlibEncodeInt16,
+	},
+	{
+		DBTypeName: "SMALLINT",
+		Type:       "SMALLINT",
+		ColumnType: "sql.NullInt16",
+		Native:     int16ExprString,
+		Decode:     StdlibDecodeInt16,
+		Encode:     StdlibEncodeInt16,
+	},
+	{
+		DBTypeName: "YEAR",
+		Type:       "YEAR",
+		ColumnType: "sql.NullInt16",
+		Native:     int16ExprString,
+		Decode:     StdlibDecodeInt16,
+		Encode:     StdlibEncodeInt16,
+	},
+	{
+		DBTypeName: "MEDIUMINT",
+		Type:       "MEDIUMINT",
+		ColumnType: "sql.NullInt32",
+		Native:     int32ExprString,
+		Decode:     StdlibDecodeInt32,
+		Encode:     StdlibEncodeInt32,
+	},
+	{
+		DBTypeName: "INT",
+		Type:       "INT",
+		ColumnType: "sql.NullInt32",
+		Native:     int32ExprString,
+		Decode:     StdlibDecodeInt32,
+		Encode:     StdlibEncodeInt32,
+	},
+	{
+		DBTypeName: "BIGINT",
+		Type:       "BIGINT",
+		ColumnType: "sql.NullInt64",
+		Native:     int64ExprString,
+		Decode:     StdlibDecodeInt64,
+		Encode:     StdlibEncodeInt64,
+	},
+	{
+		DBTypeName: "BIGINT UNSIGNED",
+		Type:       "BIGINT UNSIGNED",
+		ColumnType: "sql.Null[uint64]",
+		Native:     uint64ExprString,
+		Decode:     StdlibDecodeUint64,
+		Encode:     StdlibEncodeUint64,
+	},
+	{
+		DBTypeName: "FLOAT",
+		Type:       "FLOAT",
+		ColumnType: "sql.NullFloat64",
+		Native:     float32ExprString,
+		Decode:     StdlibDecodeFloat64,
+		Encode:     StdlibEncodeFloat64,
+	},
+	{
+		DBTypeName: "DOUBLE",
+		Type:       "DOUBLE",
+		ColumnType: "sql.NullFloat64",
+		Native:     float64ExprString,
+		Decode:     StdlibDecodeFloat64,
+		Encode:     StdlibEncodeFloat64,
+	},
+	{
+		DBTypeName: "DECIMAL",
+		Type:       "DECIMAL",
+		ColumnType: "sql.NullFloat64",
+		Native:     float64ExprString,
+		Decode:     StdlibDecodeFloat64,
+		Encode:     StdlibEncodeFloat64,
+	},
+	{
+		DBTypeName: "BOOLEAN",
+		Type:       "BOOLEAN",
+		ColumnType: "sql.NullBool",
+		Native:     boolExprString,
+		Decode:     StdlibDecodeBool,
+		Encode:     StdlibEncodeBool,
+	},
+	{
+		DBTypeName: "CHAR",
+		Type:       "CHAR",
+		ColumnType: "sql.NullString",
+		Native:     stringExprString,
+		Decode:     StdlibDecodeString,
+		Encode:     StdlibEncodeString,
+	},
+	{
+		DBTypeName: "VARCHAR",
+		Type:       "VARCHAR",
+		ColumnType: "sql.NullString",
+		Native:     stringExprString,
+		Decode:     StdlibDecodeString,
+		Encode:     StdlibEncodeString,
+	},
+	{
+		DBTypeName: "TINYTEXT",
+		Type:       "TINYTEXT",
+		ColumnType: "sql.NullString",
+		Native:     stringExprString,
+		Decode:     StdlibDecodeString,
+		Encode:     StdlibEncodeString,
+	},
+	{
+		DBTypeName: "TEXT",
+		Type:       "TEXT",
+		ColumnType: "sql.NullString",
+		Native:     stringExprString,
+		Decode:     StdlibDecodeString,
+		Encode:     StdlibEncodeString,
+	},
+	{
+		DBTypeName: "MEDIUMTEXT",
+		Type:       "MEDIUMTEXT",
+		ColumnType: "sql.NullString",
+		Native:     stringExprString,
+		Decode:     StdlibDecodeString,
+		Encode:     StdlibEncodeString,
+	},
+	{
+		DBTypeName: "LONGTEXT",
+		Type:       "LONGTEXT",
+		ColumnType: "sql.NullString",
+		Native:     stringExprString,
+		Decode:     StdlibDecodeString,
+		Encode:     StdlibEncodeString,
+	},
+	{
+		DBTypeName: "ENUM",
+		Type:       "ENUM",
+		ColumnType: "sql.NullString",
+		Native:     stringExprString,
+		Decode:     StdlibDecodeString,
+		Encode:     StdlibEncodeString,
+	},
+	{
+		DBTypeName: "SET",
+		Type:       "SET",
+		ColumnType: "sql.NullString",
+		Native:     stringExprString,
+		Decode:     StdlibDecodeString,
+		Encode:     StdlibEncodeString,
+	},
+	{
+		DBTypeName: "TIME",
+		Type:       "TIME",
+		ColumnType: "sql.NullString",
+		Native:     stringExprString,
+		Decode:     StdlibDecodeString,
+		Encode:     StdlibEncodeString,
+	},
+	{
+		DBTypeName: "BINARY",
+		Type:       "BINARY",
+		ColumnType: "[]byte",
+		Native:     bytesExpr,
+		Decode:     sqliteDecodeBinary,
+		Encode:     sqliteEncodeBinary,
+	},
+	{
+		DBTypeName: "VARBINARY",
+		Type:       "VARBINARY",
+		ColumnType: "[]byte",
+		Native:     bytesExpr,
+		Decode:     sqliteDecodeBinary,
+		Encode:     sqliteEncodeBinary,
+	},
+	{
+		DBTypeName: "TINYBLOB",
+		Type:       "TINYBLOB",
+		ColumnType: "[]byte",
+		Native:     bytesExpr,
+		Decode:     sqliteDecodeBinary,
+		Encode:     sqliteEncodeBinary,
+	},
+	{
+		DBTypeName: "BLOB",
+		Type:       "BLOB",
+		ColumnType: "[]byte",
+		Native:     bytesExpr,
+		Decode:     sqliteDecodeBinary,
+		Encode:     sqliteEncodeBinary,
+	},
+	{
+		DBTypeName: "MEDIUMBLOB",
+		Type:       "MEDIUMBLOB",
+		ColumnType: "[]byte",
+		Native:     bytesExpr,
+		Decode:     sqliteDecodeBinary,
+		Encode:     sqliteEncodeBinary,
+	},
+	{
+		DBTypeName: "LONGBLOB",
+		Type:       "LONGBLOB",
+		ColumnType: "[]byte",
+		Native:     bytesExpr,
+		Decode:     sqliteDecodeBinary,
+		Encode:     sqliteEncodeBinary,
+	},
+	{
+		DBTypeName: "BIT",
+		Type:       "BIT",
+		ColumnType: "[]byte",
+		Native:     bytesExpr,
+		Decode:     sqliteDecodeBinary,
+		Encode:     sqliteEncodeBinary,
+	},
+	{
+		DBTypeName: "JSON",
+		Type:       "JSON",
+		ColumnType: "[]byte",
+		Native:     bytesExpr,
+		Decode:     sqliteDecodeBinary,
+		Encode:     sqliteEncodeBinary,
+	},
+	{
+		DBTypeName: "DATE",
+		Type:       "DATE",
+		ColumnType: "sql.NullTime",
+		Native:     timeExprString,
+		Decode:     sqliteDecodeTime,
+		Encode:     StdlibEncodeTime,
+	},
+	{
+		DBTypeName: "DATETIME",
+		Type:       "DATETIME",
+		ColumnType: "sql.NullTime",
+		Native:     timeExprString,
+		Decode:     sqliteDecodeTime,
+		Encode:     StdlibEncodeTime,
+	},
+	{
+		DBTypeName: "TIMESTAMP",
+		Type:       "TIMESTAMP",
+		ColumnType: "sql.NullTime",
+		Native:     timeExprString,
+		Decode:     sqliteDecodeTime,
+		Encode:     StdlibEncodeTime,
+	},
+}
@@ -0,0 +1,62 @@
+package drivers_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/james-lawrence/genieql"
+	. "github.com/james-lawrence/genieql/internal/drivers"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMySQL(t *testing.T) {
+	t.Run("should register the driver", func(t *testing.T) {
+		_, err := genieql.LookupDriver(MySQL)
+		require.NoError(t, err)
+	})
+
+	t.Run("LookupType", func(t *testing.T) {
+		testfn := lookupDefinitionTestStdlib(errorsx.Must(genieql.LookupDriver(MySQL)).LookupType)
+
+		t.Run("unimplemented (rune)", func(t *testing.T) {
+			testfn(t, "rune", "", errors.New("failed"))
+		})
+
+		t.Run("int16 (TINYINT)", func(t *testing.T) {
+			testfn(t, "TINYINT", "sql.NullInt16", nil)
+		})
+
+		t.Run("int32 (INT)", func(t *testing.T) {
+			testfn(t, "INT", "sql.NullInt32", nil)
+		})
+
+		t.Run("int64 (BIGINT)", func(t *testing.T) {
+			testfn(t, "BIGINT", "sql.NullInt64", nil)
+		})
+
+		t.Run("uint64 (BIGINT UNSIGNED)", func(t *testing.T) {
+			testfn(t, "BIGINT UNSIGNED", "sql.Null[uint64]", nil)
+		})
+
+		t.Run("float64 (DECIMAL)", func(t *testing.T) {
+			testfn(t, "DECIMAL", "sql.NullFloat64", nil)
+		})
+
+		t.Run("string (VARCHAR)", func(t *testing.T) {
+			testfn(t, "VARCHAR", "sql.NullString", nil)
+		})
+
+		t.Run("bytes (JSON)", func(t *testing.T) {
+			testfn(t, "JSON", "[]byte", nil)
+		})
+
+		t.Run("bool (BOOLEAN)", func(t *testing.T) {
+			testfn(t, "BOOLEAN", "sql.NullBool", nil)
+		})
+
+		t.Run("time.Time (DATETIME)", func(t *testing.T) {
+			testfn(t, "DATETIME", "sql.NullTime", nil)
+		})
+	})
+}
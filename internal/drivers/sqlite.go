@@ -0,0 +1,112 @@
+package drivers
+
+import (
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+)
+
+const (
+	SQLite    = "modernc.org/sqlite"
+	SQLiteCGO = "github.com/mattn/go-sqlite3"
+)
+
+// implements the pure go sqlite driver https://gitlab.com/cznic/sqlite
+// and the cgo driver https://github.com/mattn/go-sqlite3 which decodes the same types.
+func init() {
+	errorsx.MaybePanic(genieql.RegisterDriver(SQLite, NewDriver(SQLite, sqlite...)))
+	errorsx.MaybePanic(genieql.RegisterDriver(SQLiteCGO, NewDriver(SQLiteCGO, sqlite...)))
+}
+
+const (
+	sqliteDecodeTime = `func() {
+		if {{ .From | expr }}.Valid {
+			tmp := {{ .From | expr }}.Time
+			{{ .To | autodereference | expr }} = {{ if .Column.Definition.Nullable }}&tmp{{ else }}tmp{{ end }}
+		}
+	}`
+
+	sqliteDecodeBinary = `func() {
+		{{ .To | expr }} = {{ .From | expr }}
+	}`
+
+	sqliteEncodeBinary = `func() {
+		{{ .To | expr }} = {{ .From | expr }}
+	}`
+)
+
+// the storage classes of sqlite, along with the declared types the drivers
+// decode into go types. see https://www.sqlite.org/datatype3.html
+var sqlite = []genieql.ColumnDefinition{
+	{
+		DBTypeName: "INTEGER",
+		Type:       "INTEGER",
+		ColumnType: "sql.NullInt64",
+		Native:     int64ExprString,
+		Decode:     StdlibDecodeInt64,
+		Encode:     StdlibEncodeInt64,
+	},
+	{
+		DBTypeName: "REAL",
+		Type:       "REAL",
+		ColumnType: "sql.NullFloat64",
+		Native:     float64ExprString,
+		Decode:     StdlibDecodeFloat64,
+		Encode:     StdlibEncodeFloat64,
+	},
+	{
+		DBTypeName: "NUMERIC",
+		Type:       "NUMERIC",
+		ColumnType: "sql.NullFloat64",
+		Native:     float64ExprString,
+		Decode:     StdlibDecodeFloat64,
+		Encode:     StdlibEncodeFloat64,
+	},
+	{
+		DBTypeName: "TEXT",
+		Type:       "TEXT",
+		ColumnType: "sql.NullString",
+		Native:     stringExprString,
+		Decode:     StdlibDecodeString,
+		Encode:     StdlibEncodeString,
+	},
+	{
+		DBTypeName: "BLOB",
+		Type:       "BLOB",
+		ColumnType: "[]byte",
+		Native:     bytesExpr,
+		Decode:     sqliteDecodeBinary,
+		Encode:     sqliteEncodeBinary,
+	},
+	{
+		DBTypeName: "BOOLEAN",
+		Type:       "BOOLEAN",
+		ColumnType: "sql.NullBool",
+		Native:     boolExprString,
+		Decode:     StdlibDecodeBool,
+		Encode:     StdlibEncodeBool,
+	},
+	{
+		DBTypeName: "DATE",
+		Type:       "DATE",
+		ColumnType: "sql.NullTime",
+		Native:     timeExprString,
+		Decode:     sqliteDecodeTime,
+		Encode:     StdlibEncodeTime,
+	},
+	{
+		DBTypeName: "DATETIME",
+		Type:       "DATETIME",
+		ColumnType: "sql.NullTime",
+		Native:     timeExprString,
+		Decode:     sqliteDecodeTime,
+		Encode:     StdlibEncodeTime,
+	},
+	{
+		DBTypeName: "TIMESTAMP",
+		Type:       "TIMESTAMP",
+		ColumnType: "sql.NullTime",
+		Native:     timeExprString,
+		Decode:     sqliteDecodeTime,
+		Encode:     StdlibEncodeTime,
+	},
+}
@@ -0,0 +1,60 @@
+package drivers_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/james-lawrence/genieql"
+	. "github.com/james-lawrence/genieql/internal/drivers"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLite(t *testing.T) {
+	t.Run("should register the drivers", func(t *testing.T) {
+		_, err := genieql.LookupDriver(SQLite)
+		require.NoError(t, err)
+		_, err = genieql.LookupDriver(SQLiteCGO)
+		require.NoError(t, err)
+	})
+
+	t.Run("LookupType", func(t *testing.T) {
+		testfn := lookupDefinitionTestStdlib(errorsx.Must(genieql.LookupDriver(SQLite)).LookupType)
+
+		t.Run("unimplemented (rune)", func(t *testing.T) {
+			testfn(t, "rune", "", errors.New("failed"))
+		})
+
+		t.Run("int64 (INTEGER)", func(t *testing.T) {
+			testfn(t, "INTEGER", "sql.NullInt64", nil)
+		})
+
+		t.Run("float64 (REAL)", func(t *testing.T) {
+			testfn(t, "REAL", "sql.NullFloat64", nil)
+		})
+
+		t.Run("float64 (NUMERIC)", func(t *testing.T) {
+			testfn(t, "NUMERIC", "sql.NullFloat64", nil)
+		})
+
+		t.Run("string (TEXT)", func(t *testing.T) {
+			testfn(t, "TEXT", "sql.NullString", nil)
+		})
+
+		t.Run("bytes (BLOB)", func(t *testing.T) {
+			testfn(t, "BLOB", "[]byte", nil)
+		})
+
+		t.Run("bool (BOOLEAN)", func(t *testing.T) {
+			testfn(t, "BOOLEAN", "sql.NullBool", nil)
+		})
+
+		t.Run("time.Time (DATETIME)", func(t *testing.T) {
+			testfn(t, "DATETIME", "sql.NullTime", nil)
+		})
+
+		t.Run("time.Time (TIMESTAMP)", func(t *testing.T) {
+			testfn(t, "TIMESTAMP", "sql.NullTime", nil)
+		})
+	})
+}
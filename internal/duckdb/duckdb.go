@@ -1,23 +1,29 @@
 package duckdb
 
 import (
+	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"go/ast"
 	"go/types"
 	"log"
+	"strings"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/duckdb/duckdb-go/v2"
 	"golang.org/x/text/transform"
 
 	"github.com/james-lawrence/genieql"
 	"github.com/james-lawrence/genieql/astutil"
 	"github.com/james-lawrence/genieql/columninfo"
 	"github.com/james-lawrence/genieql/dialects"
+	"github.com/james-lawrence/genieql/internal/ddl"
 	"github.com/james-lawrence/genieql/internal/debugx"
 	"github.com/james-lawrence/genieql/internal/errorsx"
 	"github.com/james-lawrence/genieql/internal/langx"
 	"github.com/james-lawrence/genieql/internal/md5x"
+	"github.com/james-lawrence/genieql/internal/sqlx"
 	"github.com/james-lawrence/genieql/internal/transformx"
 )
 
@@ -47,6 +53,16 @@ func (t dialectFactory) Connect(config genieql.Configuration) (_ genieql.Dialect
 	return DialectFn{db: db}, nil
 }
 
+// Offline describes the tables using the DDL migrations of the configuration.
+func (t dialectFactory) Offline(config genieql.Configuration) (genieql.Dialect, error) {
+	schema, err := ddl.ReadDir(config.MigrationsDirectory())
+	if err != nil {
+		return nil, err
+	}
+
+	return ddl.NewDialect(DialectFn{}, schema, lookupDeclared, ddl.ByName), nil
+}
+
 type DialectFn struct {
 	db *sql.DB
 }
@@ -67,6 +83,34 @@ func (t DialectFn) Delete(table string, columns, predicates []string) string {
 	return Delete(table, columns, predicates)
 }
 
+func (t DialectFn) UpdateBatch(n int, table string, columns, types, predicates, returning []string) string {
+	return UpdateBatch(n, table, columns, types, predicates, returning)
+}
+
+func (t DialectFn) DeleteBatch(n int, table string, columns, types, returning []string) string {
+	return DeleteBatch(n, table, columns, types, returning)
+}
+
+func (t DialectFn) Conflict(target, updates []string) string {
+	return Conflict(target, updates)
+}
+
+func (t DialectFn) Paginate(table string, columns, keys []string, after bool) string {
+	return Paginate(table, columns, keys, after)
+}
+
+func (t DialectFn) Membership(placeholder string) string {
+	return Membership(placeholder)
+}
+
+func (t DialectFn) MaxParameters() int {
+	return MaxParameters
+}
+
+func (t DialectFn) Returning() bool {
+	return true
+}
+
 func (t DialectFn) ColumnValueTransformer() genieql.ColumnTransformer {
 	return &columnValueTransformer{}
 }
@@ -106,6 +150,14 @@ func (t DialectFn) ColumnInformationForQuery(d genieql.Driver, query string) (_
 	return columnInformation(d, tx, columnInformationQuery, table)
 }
 
+func (t DialectFn) ParameterInformationForQuery(d genieql.Driver, query string) ([]genieql.ColumnInfo, error) {
+	return parameterInformation(d, t.db, query)
+}
+
+func (t DialectFn) Validate(query string) error {
+	return sqlx.Validate(t.db, query)
+}
+
 func (t DialectFn) QuotedString(s string) string {
 	return quotedString(s)
 }
@@ -168,6 +220,52 @@ func columnInformation(d genieql.Driver, q queryer, query, table string) ([]geni
 	return columns, errorsx.Wrap(rows.Err(), "error retrieving column information")
 }
 
+// lookupDeclared resolves the declared type of a column using the name duckdb describes it by.
+// i.e.) INT4 is INTEGER, VARCHAR(255) is VARCHAR
+func lookupDeclared(d genieql.Driver, declared string) (genieql.ColumnDefinition, error) {
+	name := strings.ToUpper(strings.TrimSpace(declared))
+	name, array := strings.CutSuffix(name, "[]")
+
+	if start := strings.Index(name, "("); start >= 0 {
+		if end := strings.Index(name[start:], ")"); end >= 0 {
+			name = name[:start] + name[start+end+1:]
+		}
+	}
+	name = strings.Join(strings.Fields(name), " ")
+
+	switch name {
+	case "INT", "INT4", "SIGNED":
+		name = "INTEGER"
+	case "INT8", "LONG":
+		name = "BIGINT"
+	case "INT2", "SHORT":
+		name = "SMALLINT"
+	case "TEXT", "STRING", "CHAR", "BPCHAR":
+		name = "VARCHAR"
+	case "BOOL", "LOGICAL":
+		name = "BOOLEAN"
+	case "FLOAT4", "REAL":
+		name = "FLOAT"
+	case "FLOAT8":
+		name = "DOUBLE"
+	case "BYTEA", "VARBINARY":
+		name = "BLOB"
+	case "TIMESTAMPTZ":
+		name = "TIMESTAMP WITH TIME ZONE"
+	}
+
+	if array {
+		name += "[]"
+	}
+
+	expr := totypeexpr(name)
+	if expr == nil {
+		expr = astutil.Expr(name)
+	}
+
+	return d.LookupType(types.ExprString(expr))
+}
+
 // OIDToType maps object id to golang types.
 func totypeexpr(id string) ast.Expr {
 	// if strings.HasPrefix(id, "DECIMAL") {
@@ -215,3 +313,101 @@ func totypeexpr(id string) ast.Expr {
 		return nil
 	}
 }
+
+// parameterInformation prepares the query to determine the type of each placeholder.
+func parameterInformation(d genieql.Driver, db *sql.DB, query string) (params []genieql.ColumnInfo, err error) {
+	var (
+		conn *sql.Conn
+	)
+
+	if conn, err = db.Conn(context.Background()); err != nil {
+		return nil, errorsx.Wrap(err, "unable to acquire connection")
+	}
+	defer conn.Close()
+
+	err = conn.Raw(func(dc any) (err error) {
+		var (
+			dconn *duckdb.Conn
+			ds    driver.Stmt
+			stmt  *duckdb.Stmt
+			ok    bool
+		)
+
+		if dconn, ok = dc.(*duckdb.Conn); !ok {
+			return errorsx.Errorf("unable to describe query, expected a duckdb connection found %T", dc)
+		}
+
+		if ds, err = dconn.PrepareContext(context.Background(), query); err != nil {
+			return errorsx.Wrapf(err, "unable to prepare query: %s", query)
+		}
+		defer ds.Close()
+
+		if stmt, ok = ds.(*duckdb.Stmt); !ok {
+			return errorsx.Errorf("unexpected statement type %T", ds)
+		}
+
+		for idx := 1; idx <= stmt.NumInput(); idx++ {
+			var (
+				ptype     duckdb.Type
+				columndef genieql.ColumnDefinition
+			)
+
+			if ptype, err = stmt.ParamType(idx); err != nil {
+				return errorsx.Wrapf(err, "unable to determine type of parameter %d", idx)
+			}
+
+			// types unknown to the driver, i.e.) untyped placeholders, are left for the caller to skip.
+			if expr := totypeexpr(paramtypename(ptype)); expr != nil {
+				if columndef, err = d.LookupType(types.ExprString(expr)); err != nil {
+					debugx.Println("unknown parameter type", idx, types.ExprString(expr), err)
+					columndef = genieql.ColumnDefinition{}
+				}
+			}
+
+			params = append(params, genieql.ColumnInfo{
+				Name:       fmt.Sprintf("$%d", idx),
+				Definition: columndef,
+			})
+		}
+
+		return nil
+	})
+
+	return params, err
+}
+
+// paramtypename the name DESCRIBE reports for the type of a parameter.
+func paramtypename(t duckdb.Type) string {
+	switch t {
+	case duckdb.TYPE_BOOLEAN:
+		return "BOOLEAN"
+	case duckdb.TYPE_SMALLINT:
+		return "SMALLINT"
+	case duckdb.TYPE_INTEGER:
+		return "INTEGER"
+	case duckdb.TYPE_BIGINT:
+		return "BIGINT"
+	case duckdb.TYPE_USMALLINT:
+		return "USMALLINT"
+	case duckdb.TYPE_UINTEGER:
+		return "UINTEGER"
+	case duckdb.TYPE_UBIGINT:
+		return "UBIGINT"
+	case duckdb.TYPE_FLOAT:
+		return "FLOAT"
+	case duckdb.TYPE_DOUBLE:
+		return "DOUBLE"
+	case duckdb.TYPE_VARCHAR:
+		return "VARCHAR"
+	case duckdb.TYPE_TIMESTAMP_TZ:
+		return "TIMESTAMP WITH TIME ZONE"
+	case duckdb.TYPE_INTERVAL:
+		return "INTERVAL"
+	case duckdb.TYPE_BLOB:
+		return "BLOB"
+	case duckdb.TYPE_UUID:
+		return "UUID"
+	default:
+		return ""
+	}
+}
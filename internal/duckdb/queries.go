@@ -2,6 +2,7 @@ package duckdb
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/james-lawrence/genieql"
@@ -63,6 +64,85 @@ func Delete(table string, columns, predicates []string) string {
 	return fmt.Sprintf(deleteTmpl, quotedString(table), strings.Join(clauses, " AND "))
 }
 
+// UpdateBatch generates a query updating many rows by joining the table against a VALUES
+// common table expression.
+func UpdateBatch(n int, table string, columns, types, predicates, returning []string) string {
+	assignments := make([]string, 0, len(columns))
+	for _, c := range columns {
+		if slices.Contains(predicates, c) {
+			continue
+		}
+
+		assignments = append(assignments, fmt.Sprintf("%s = v.%s", quotedString(c), quotedString(c)))
+	}
+
+	clauses := make([]string, 0, len(predicates))
+	for _, c := range quotedColumns(predicates...) {
+		clauses = append(clauses, fmt.Sprintf("%s.%s = v.%s", quotedString(table), c, c))
+	}
+
+	return fmt.Sprintf(
+		updateBatchTmpl,
+		strings.Join(quotedColumns(columns...), ","),
+		strings.Join(batchValues(n, len(columns)), ","),
+		quotedString(table),
+		strings.Join(assignments, ", "),
+		strings.Join(clauses, " AND "),
+		strings.Join(qualifiedColumns(table, returning...), ","),
+	)
+}
+
+// DeleteBatch generates a query deleting many rows matching a VALUES common table expression.
+func DeleteBatch(n int, table string, columns, types, returning []string) string {
+	columnOrder := strings.Join(quotedColumns(columns...), ",")
+	return fmt.Sprintf(
+		deleteBatchTmpl,
+		columnOrder,
+		strings.Join(batchValues(n, len(columns)), ","),
+		quotedString(table),
+		columnOrder,
+		columnOrder,
+		strings.Join(quotedColumns(returning...), ","),
+	)
+}
+
+// Conflict generates an upsert clause.
+func Conflict(target, updates []string) string {
+	if len(updates) == 0 {
+		return fmt.Sprintf("ON CONFLICT (%s) DO NOTHING", strings.Join(quotedColumns(target...), ","))
+	}
+
+	assignments := make([]string, 0, len(updates))
+	for _, c := range quotedColumns(updates...) {
+		assignments = append(assignments, fmt.Sprintf("%s = EXCLUDED.%s", c, c))
+	}
+
+	return fmt.Sprintf("ON CONFLICT (%s) DO UPDATE SET %s", strings.Join(quotedColumns(target...), ","), strings.Join(assignments, ", "))
+}
+
+// Paginate generates a keyset pagination query.
+func Paginate(table string, columns, keys []string, after bool) string {
+	columnOrder := strings.Join(quotedColumns(columns...), ",")
+	keyOrder := strings.Join(quotedColumns(keys...), ",")
+
+	if !after {
+		return fmt.Sprintf(paginateTmpl, columnOrder, quotedString(table), matchAllClause, keyOrder, 1)
+	}
+
+	p, offset := placeholders(1, selectPlaceholder(keys, nil))
+	clause := fmt.Sprintf("(%s) > (%s)", keyOrder, strings.Join(p, ","))
+	return fmt.Sprintf(paginateTmpl, columnOrder, quotedString(table), clause, keyOrder, offset)
+}
+
+// MaxParameters duckdb does not limit the number of parameters.
+const MaxParameters = 0
+
+// Membership the values are expanded at runtime since binding lists
+// is not supported by the driver.
+func Membership(placeholder string) string {
+	return ""
+}
+
 // predicate formats WHERE clauses with placeholders.
 func predicate(offset int, predicates ...string) ([]string, int) {
 	clauses := make([]string, 0, len(predicates))
@@ -77,6 +157,21 @@ func predicate(offset int, predicates ...string) ([]string, int) {
 	return clauses, offset + len(predicates)
 }
 
+// batchValues formats n tuples of positional parameters for the columns.
+func batchValues(n int, columns int) []string {
+	values := make([]string, 0, n)
+	for i := range n {
+		p := make([]string, 0, columns)
+		for j := range columns {
+			ph, _ := offsetPlaceholder{}.String(i*columns + j + 1)
+			p = append(p, ph)
+		}
+		values = append(values, fmt.Sprintf("(%s)", strings.Join(p, ",")))
+	}
+
+	return values
+}
+
 // placeholders formats values with positional parameters.
 func placeholders(offset int, columns []placeholder) ([]string, int) {
 	clauses := make([]string, 0, len(columns))
@@ -112,6 +207,15 @@ func quotedString(s string) string {
 	return fmt.Sprintf("\"%s\"", s)
 }
 
+// qualifiedColumns qualifies the columns by the table, the values of batch queries share the column names.
+func qualifiedColumns(table string, columns ...string) []string {
+	results := make([]string, 0, len(columns))
+	for _, c := range quotedColumns(columns...) {
+		results = append(results, quotedString(table)+"."+c)
+	}
+	return results
+}
+
 func quotedColumns(columns ...string) []string {
 	results := make([]string, len(columns))
 	for i, c := range columns {
@@ -139,4 +243,7 @@ func (t offsetPlaceholder) String(offset int) (string, int) {
 const selectByFieldTmpl = "SELECT %s FROM %s WHERE %s"
 const updateTmpl = "UPDATE %s SET %s WHERE %s RETURNING %s"
 const deleteTmpl = "DELETE FROM %s WHERE %s"
+const updateBatchTmpl = "WITH v(%s) AS (VALUES %s) UPDATE %s SET %s FROM v WHERE %s RETURNING %s"
+const deleteBatchTmpl = "WITH v(%s) AS (VALUES %s) DELETE FROM %s WHERE (%s) IN (SELECT %s FROM v) RETURNING %s"
+const paginateTmpl = "SELECT %s FROM %s WHERE %s ORDER BY %s LIMIT $%d"
 const matchAllClause = "TRUE"
@@ -166,4 +166,134 @@ func TestQueries(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("UpdateBatch", func(t *testing.T) {
+		cases := []struct {
+			name       string
+			n          int
+			table      string
+			columns    []string
+			predicates []string
+			expected   string
+		}{
+			{
+				name:       "example 1",
+				n:          2,
+				table:      "MyTable1",
+				columns:    []string{"col1", "col2", "col3"},
+				predicates: []string{"col1"},
+				expected:   "WITH v(\"col1\",\"col2\",\"col3\") AS (VALUES ($1,$2,$3),($4,$5,$6)) UPDATE \"MyTable1\" SET \"col2\" = v.\"col2\", \"col3\" = v.\"col3\" FROM v WHERE \"MyTable1\".\"col1\" = v.\"col1\" RETURNING \"MyTable1\".\"col1\",\"MyTable1\".\"col2\",\"MyTable1\".\"col3\"",
+			},
+			{
+				name:       "example 2",
+				n:          1,
+				table:      "MyTable2",
+				columns:    []string{"col1", "col2", "col3"},
+				predicates: []string{"col1", "col2"},
+				expected:   "WITH v(\"col1\",\"col2\",\"col3\") AS (VALUES ($1,$2,$3)) UPDATE \"MyTable2\" SET \"col3\" = v.\"col3\" FROM v WHERE \"MyTable2\".\"col1\" = v.\"col1\" AND \"MyTable2\".\"col2\" = v.\"col2\" RETURNING \"MyTable2\".\"col1\",\"MyTable2\".\"col2\",\"MyTable2\".\"col3\"",
+			},
+		}
+
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				require.Equal(t, c.expected, UpdateBatch(c.n, c.table, c.columns, nil, c.predicates, c.columns))
+			})
+		}
+	})
+
+	t.Run("DeleteBatch", func(t *testing.T) {
+		cases := []struct {
+			name      string
+			n         int
+			table     string
+			columns   []string
+			returning []string
+			expected  string
+		}{
+			{
+				name:      "example 1",
+				n:         2,
+				table:     "MyTable1",
+				columns:   []string{"col1"},
+				returning: []string{"col1", "col2"},
+				expected:  "WITH v(\"col1\") AS (VALUES ($1),($2)) DELETE FROM \"MyTable1\" WHERE (\"col1\") IN (SELECT \"col1\" FROM v) RETURNING \"col1\",\"col2\"",
+			},
+			{
+				name:      "example 2",
+				n:         2,
+				table:     "MyTable2",
+				columns:   []string{"col1", "col2"},
+				returning: []string{"col1", "col2", "col3"},
+				expected:  "WITH v(\"col1\",\"col2\") AS (VALUES ($1,$2),($3,$4)) DELETE FROM \"MyTable2\" WHERE (\"col1\",\"col2\") IN (SELECT \"col1\",\"col2\" FROM v) RETURNING \"col1\",\"col2\",\"col3\"",
+			},
+		}
+
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				require.Equal(t, c.expected, DeleteBatch(c.n, c.table, c.columns, nil, c.returning))
+			})
+		}
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		cases := []struct {
+			name     string
+			target   []string
+			updates  []string
+			expected string
+		}{
+			{
+				name:     "example 1",
+				target:   []string{"col1"},
+				updates:  []string{},
+				expected: "ON CONFLICT (\"col1\") DO NOTHING",
+			},
+			{
+				name:     "example 2",
+				target:   []string{"col1", "col2"},
+				updates:  []string{"col3", "col4"},
+				expected: "ON CONFLICT (\"col1\",\"col2\") DO UPDATE SET \"col3\" = EXCLUDED.\"col3\", \"col4\" = EXCLUDED.\"col4\"",
+			},
+		}
+
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				require.Equal(t, c.expected, Conflict(c.target, c.updates))
+			})
+		}
+	})
+
+	t.Run("Paginate", func(t *testing.T) {
+		cases := []struct {
+			name     string
+			table    string
+			columns  []string
+			keys     []string
+			after    bool
+			expected string
+		}{
+			{
+				name:     "example 1",
+				table:    "MyTable1",
+				columns:  []string{"col1", "col2", "col3"},
+				keys:     []string{"col1"},
+				after:    false,
+				expected: "SELECT \"col1\",\"col2\",\"col3\" FROM \"MyTable1\" WHERE TRUE ORDER BY \"col1\" LIMIT $1",
+			},
+			{
+				name:     "example 2",
+				table:    "MyTable2",
+				columns:  []string{"col1", "col2", "col3"},
+				keys:     []string{"col2", "col1"},
+				after:    true,
+				expected: "SELECT \"col1\",\"col2\",\"col3\" FROM \"MyTable2\" WHERE (\"col2\",\"col1\") > ($1,$2) ORDER BY \"col2\",\"col1\" LIMIT $3",
+			},
+		}
+
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				require.Equal(t, c.expected, Paginate(c.table, c.columns, c.keys, c.after))
+			})
+		}
+	})
 }
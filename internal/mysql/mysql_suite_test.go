@@ -0,0 +1,13 @@
+package mysql_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestMysql(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Mysql Suite")
+}
@@ -0,0 +1,205 @@
+package mysql
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/internal/stringsx"
+)
+
+// ColumnValueTransformer mysql placeholders are positional.
+type ColumnValueTransformer struct{}
+
+func (t ColumnValueTransformer) Transform(c genieql.ColumnInfo) string {
+	return placeholder
+}
+
+// Insert generate an insert query. mysql is unable to return the inserted rows,
+// the projection is ignored.
+func Insert(n int, offset int, table string, conflict string, columns, projection, defaulted []string) string {
+	const (
+		insertTmpl = "INSERT INTO :gql.insert.tablename: (:gql.insert.columns:) VALUES :gql.insert.values::gql.insert.conflict:"
+	)
+
+	values := make([]string, 0, n)
+	for range n {
+		values = append(values, fmt.Sprintf("(%s)", strings.Join(placeholders(columns, defaulted), ",")))
+	}
+
+	replacements := strings.NewReplacer(
+		":gql.insert.tablename:", table,
+		":gql.insert.columns:", strings.Join(quotedColumns(columns...), ","),
+		":gql.insert.values:", strings.Join(values, ","),
+		":gql.insert.conflict:", stringsx.DefaultIfBlank(" "+conflict, ""),
+	)
+
+	return replacements.Replace(insertTmpl)
+}
+
+// Select generate a select query.
+func Select(table string, columns, predicates []string) string {
+	columnOrder := strings.Join(quotedColumns(columns...), ",")
+	return fmt.Sprintf(selectByFieldTmpl, columnOrder, table, strings.Join(predicate(predicates...), " AND "))
+}
+
+// Update generate an update query. mysql is unable to return the updated rows,
+// the returning columns are ignored.
+func Update(table string, columns, predicates, returning []string) string {
+	return fmt.Sprintf(updateTmpl, table, strings.Join(predicate(columns...), ", "), strings.Join(predicate(predicates...), " AND "))
+}
+
+// Delete generate a delete query. mysql is unable to return the deleted rows,
+// the columns are ignored.
+func Delete(table string, columns, predicates []string) string {
+	return fmt.Sprintf(deleteTmpl, table, strings.Join(predicate(predicates...), " AND "))
+}
+
+// UpdateBatch generate a query updating many rows by joining the table against a derived
+// table of the values.
+func UpdateBatch(n int, table string, columns, types, predicates, returning []string) string {
+	assignments := make([]string, 0, len(columns))
+	for _, c := range quotedColumns(columns...) {
+		if slices.Contains(quotedColumns(predicates...), c) {
+			continue
+		}
+
+		assignments = append(assignments, fmt.Sprintf("t.%s = v.%s", c, c))
+	}
+
+	return fmt.Sprintf(
+		updateBatchTmpl,
+		table,
+		derived(n, columns),
+		strings.Join(batchJoin(predicates), " AND "),
+		strings.Join(assignments, ", "),
+	)
+}
+
+// DeleteBatch generate a query deleting many rows by joining the table against a derived
+// table of the values.
+func DeleteBatch(n int, table string, columns, types, returning []string) string {
+	return fmt.Sprintf(
+		deleteBatchTmpl,
+		table,
+		derived(n, columns),
+		strings.Join(batchJoin(columns), " AND "),
+	)
+}
+
+// Conflict generate an upsert clause. mysql resolves conflicts against every unique
+// index of the table, the target is only used to leave conflicting rows untouched.
+func Conflict(target, updates []string) string {
+	if len(updates) == 0 {
+		c := quotedString(target[0])
+		return fmt.Sprintf("ON DUPLICATE KEY UPDATE %s = %s", c, c)
+	}
+
+	assignments := make([]string, 0, len(updates))
+	for _, c := range quotedColumns(updates...) {
+		assignments = append(assignments, fmt.Sprintf("%s = VALUES(%s)", c, c))
+	}
+
+	return fmt.Sprintf("ON DUPLICATE KEY UPDATE %s", strings.Join(assignments, ", "))
+}
+
+// Paginate generate a keyset pagination query.
+func Paginate(table string, columns, keys []string, after bool) string {
+	columnOrder := strings.Join(quotedColumns(columns...), ",")
+	keyOrder := strings.Join(quotedColumns(keys...), ",")
+
+	if !after {
+		return fmt.Sprintf(paginateTmpl, columnOrder, table, matchAllClause, keyOrder)
+	}
+
+	clause := fmt.Sprintf("(%s) > (%s)", keyOrder, strings.Join(placeholders(keys, nil), ","))
+	return fmt.Sprintf(paginateTmpl, columnOrder, table, clause, keyOrder)
+}
+
+// MaxParameters the protocol limits the number of placeholders of a prepared statement to a uint16.
+const MaxParameters = 65535
+
+// Membership mysql is unable to bind arrays, the values are expanded at runtime.
+func Membership(placeholder string) string {
+	return ""
+}
+
+func quotedString(s string) string {
+	return "`" + s + "`"
+}
+
+func quotedColumns(columns ...string) []string {
+	results := make([]string, 0, len(columns))
+	for _, c := range columns {
+		results = append(results, quotedString(c))
+	}
+	return results
+}
+
+func predicate(predicates ...string) []string {
+	clauses := make([]string, 0, len(predicates))
+	for _, c := range quotedColumns(predicates...) {
+		clauses = append(clauses, fmt.Sprintf("%s = %s", c, placeholder))
+	}
+
+	if len(clauses) == 0 {
+		clauses = append(clauses, matchAllClause)
+	}
+
+	return clauses
+}
+
+func placeholders(columns, defaults []string) []string {
+	results := make([]string, 0, len(columns))
+	for _, c := range columns {
+		if slices.Contains(defaults, c) {
+			results = append(results, "DEFAULT")
+			continue
+		}
+
+		results = append(results, placeholder)
+	}
+
+	return results
+}
+
+// derived builds a table of n rows of placeholders for the columns.
+// i.e.) SELECT ? AS `a`, ? AS `b` UNION ALL SELECT ?, ?
+func derived(n int, columns []string) string {
+	rows := make([]string, 0, n)
+	for i := range n {
+		values := make([]string, 0, len(columns))
+		for _, c := range quotedColumns(columns...) {
+			if i == 0 {
+				values = append(values, fmt.Sprintf("%s AS %s", placeholder, c))
+				continue
+			}
+
+			values = append(values, placeholder)
+		}
+
+		rows = append(rows, "SELECT "+strings.Join(values, ", "))
+	}
+
+	return strings.Join(rows, " UNION ALL ")
+}
+
+// batchJoin matches the rows of the table against the batch values.
+func batchJoin(predicates []string) []string {
+	clauses := make([]string, 0, len(predicates))
+	for _, c := range quotedColumns(predicates...) {
+		clauses = append(clauses, fmt.Sprintf("t.%s = v.%s", c, c))
+	}
+
+	return clauses
+}
+
+const placeholder = "?"
+const selectByFieldTmpl = "SELECT %s FROM %s WHERE %s"
+const updateTmpl = "UPDATE %s SET %s WHERE %s"
+const deleteTmpl = "DELETE FROM %s WHERE %s"
+const updateBatchTmpl = "UPDATE %s AS t JOIN (%s) AS v ON %s SET %s"
+const deleteBatchTmpl = "DELETE t FROM %s AS t JOIN (%s) AS v ON %s"
+const paginateTmpl = "SELECT %s FROM %s WHERE %s ORDER BY %s LIMIT ?"
+const matchAllClause = "TRUE"
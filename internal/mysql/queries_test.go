@@ -0,0 +1,75 @@
+package mysql_test
+
+import (
+	. "github.com/james-lawrence/genieql/internal/mysql"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("queries", func() {
+	DescribeTable("Insert",
+		func(n int, table string, conflict string, columns, defaults []string, query string) {
+			Expect(Insert(n, 0, table, conflict, columns, columns, defaults)).To(Equal(query))
+		},
+		Entry("example 1", 1, "MyTable1", "", []string{"col1", "col2", "col3"}, []string{}, "INSERT INTO MyTable1 (`col1`,`col2`,`col3`) VALUES (?,?,?)"),
+		Entry("example 2", 1, "MyTable2", "", []string{"col1", "col2", "col3", "col4"}, []string{"col4"}, "INSERT INTO MyTable2 (`col1`,`col2`,`col3`,`col4`) VALUES (?,?,?,DEFAULT)"),
+		Entry("example 3", 3, "MyTable2", "", []string{"col1", "col2", "col3", "col4"}, []string{"col1", "col3"}, "INSERT INTO MyTable2 (`col1`,`col2`,`col3`,`col4`) VALUES (DEFAULT,?,DEFAULT,?),(DEFAULT,?,DEFAULT,?),(DEFAULT,?,DEFAULT,?)"),
+		Entry("example 4", 1, "MyTable2", "ON DUPLICATE KEY UPDATE `col2` = VALUES(`col2`)", []string{"col1", "col2"}, []string{"col1"}, "INSERT INTO MyTable2 (`col1`,`col2`) VALUES (DEFAULT,?) ON DUPLICATE KEY UPDATE `col2` = VALUES(`col2`)"),
+	)
+
+	DescribeTable("Select",
+		func(table string, columns, predicates []string, query string) {
+			Expect(Select(table, columns, predicates)).To(Equal(query))
+		},
+		Entry("example 1", "MyTable1", []string{"col1", "col2", "col3"}, []string{"col1"}, "SELECT `col1`,`col2`,`col3` FROM MyTable1 WHERE `col1` = ?"),
+		Entry("example 2", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{"col1", "col2"}, "SELECT `col1`,`col2`,`col3`,`col4` FROM MyTable2 WHERE `col1` = ? AND `col2` = ?"),
+		Entry("example 3", "MyTable2", []string{"col1"}, []string{}, "SELECT `col1` FROM MyTable2 WHERE TRUE"),
+	)
+
+	DescribeTable("Update",
+		func(table string, columns, predicates []string, query string) {
+			Expect(Update(table, columns, predicates, columns)).To(Equal(query))
+		},
+		Entry("example 1", "MyTable1", []string{"col1", "col2", "col3"}, []string{"col1"}, "UPDATE MyTable1 SET `col1` = ?, `col2` = ?, `col3` = ? WHERE `col1` = ?"),
+		Entry("example 2", "MyTable2", []string{"col1", "col2"}, []string{}, "UPDATE MyTable2 SET `col1` = ?, `col2` = ? WHERE TRUE"),
+	)
+
+	DescribeTable("Delete",
+		func(table string, columns, predicates []string, query string) {
+			Expect(Delete(table, columns, predicates)).To(Equal(query))
+		},
+		Entry("example 1", "MyTable1", []string{"col1", "col2", "col3"}, []string{"col1"}, "DELETE FROM MyTable1 WHERE `col1` = ?"),
+		Entry("example 2", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{"col1", "col2"}, "DELETE FROM MyTable2 WHERE `col1` = ? AND `col2` = ?"),
+	)
+
+	DescribeTable("UpdateBatch",
+		func(n int, table string, columns, predicates []string, query string) {
+			Expect(UpdateBatch(n, table, columns, nil, predicates, columns)).To(Equal(query))
+		},
+		Entry("example 1", 2, "MyTable1", []string{"col1", "col2", "col3"}, []string{"col1"}, "UPDATE MyTable1 AS t JOIN (SELECT ? AS `col1`, ? AS `col2`, ? AS `col3` UNION ALL SELECT ?, ?, ?) AS v ON t.`col1` = v.`col1` SET t.`col2` = v.`col2`, t.`col3` = v.`col3`"),
+	)
+
+	DescribeTable("DeleteBatch",
+		func(n int, table string, columns []string, query string) {
+			Expect(DeleteBatch(n, table, columns, nil, columns)).To(Equal(query))
+		},
+		Entry("example 1", 2, "MyTable1", []string{"col1", "col2"}, "DELETE t FROM MyTable1 AS t JOIN (SELECT ? AS `col1`, ? AS `col2` UNION ALL SELECT ?, ?) AS v ON t.`col1` = v.`col1` AND t.`col2` = v.`col2`"),
+	)
+
+	DescribeTable("Conflict",
+		func(target, updates []string, clause string) {
+			Expect(Conflict(target, updates)).To(Equal(clause))
+		},
+		Entry("example 1", []string{"col1"}, []string{}, "ON DUPLICATE KEY UPDATE `col1` = `col1`"),
+		Entry("example 2", []string{"col1", "col2"}, []string{"col3", "col4"}, "ON DUPLICATE KEY UPDATE `col3` = VALUES(`col3`), `col4` = VALUES(`col4`)"),
+	)
+
+	DescribeTable("Paginate",
+		func(table string, columns, keys []string, after bool, query string) {
+			Expect(Paginate(table, columns, keys, after)).To(Equal(query))
+		},
+		Entry("example 1", "MyTable1", []string{"col1", "col2", "col3"}, []string{"col1"}, false, "SELECT `col1`,`col2`,`col3` FROM MyTable1 WHERE TRUE ORDER BY `col1` LIMIT ?"),
+		Entry("example 2", "MyTable2", []string{"col1", "col2", "col3"}, []string{"col2", "col1"}, true, "SELECT `col1`,`col2`,`col3` FROM MyTable2 WHERE (`col2`,`col1`) > (?,?) ORDER BY `col2`,`col1` LIMIT ?"),
+	)
+})
@@ -0,0 +1,146 @@
+package postgresql
+
+import (
+	"go/types"
+	"strings"
+
+	"github.com/jackc/pgtype"
+
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/astutil"
+	"github.com/james-lawrence/genieql/internal/ddl"
+	"github.com/james-lawrence/genieql/internal/postgresql/internal"
+)
+
+// Offline describes the tables using the DDL migrations of the configuration.
+// unquoted identifiers are folded to lower case like the database does.
+func (t dialectFactory) Offline(config genieql.Configuration) (genieql.Dialect, error) {
+	schema, err := ddl.ReadDir(config.MigrationsDirectory(), ddl.OptionFold(strings.ToLower))
+	if err != nil {
+		return nil, err
+	}
+
+	return ddl.NewDialect(dialectImplementation{}, schema, lookupDeclared, ddl.ByName), nil
+}
+
+// lookupDeclared resolves the declared type of a column by its internal name. i.e.) integer is int4.
+// user defined types are unknown without the catalog and are looked up by name.
+func lookupDeclared(d genieql.Driver, declared string) (columndef genieql.ColumnDefinition, err error) {
+	name := canonical(declared)
+
+	expr := astutil.Expr(name)
+	if dt, ok := pgtype.NewConnInfo().DataTypeForName(name); ok {
+		if x := internal.OIDToType(int(dt.OID)); x != nil {
+			expr = x
+		}
+	}
+
+	if columndef, err = d.LookupType(types.ExprString(expr)); err != nil {
+		return columndef, err
+	}
+
+	// the database type is required to bind arrays of the column, i.e.) batch updates.
+	if columndef.DBTypeName == "" {
+		columndef.DBTypeName = formatted(name)
+	}
+
+	return columndef, nil
+}
+
+// canonical the internal name of the declared type. modifiers are discarded and
+// arrays are prefixed with an underscore. i.e.) varchar(255)[] is _varchar
+func canonical(declared string) string {
+	name := strings.ToLower(strings.TrimSpace(declared))
+
+	array := strings.HasSuffix(name, "]")
+	if idx := strings.Index(name, "["); idx >= 0 {
+		name = strings.TrimSpace(name[:idx])
+	}
+	if trimmed, ok := strings.CutSuffix(name, " array"); ok {
+		name, array = trimmed, true
+	}
+
+	// strip type modifiers. i.e.) numeric(10,2), timestamp(6) with time zone
+	if start := strings.Index(name, "("); start >= 0 {
+		if end := strings.Index(name[start:], ")"); end >= 0 {
+			name = strings.Join(strings.Fields(name[:start]+" "+name[start+end+1:]), " ")
+		}
+	}
+	name = strings.Join(strings.Fields(name), " ")
+
+	switch name {
+	case "smallint", "smallserial", "serial2":
+		name = "int2"
+	case "int", "integer", "serial", "serial4":
+		name = "int4"
+	case "bigint", "bigserial", "serial8":
+		name = "int8"
+	case "real":
+		name = "float4"
+	case "double precision":
+		name = "float8"
+	case "decimal":
+		name = "numeric"
+	case "boolean":
+		name = "bool"
+	case "character varying", "char varying":
+		name = "varchar"
+	case "character", "char":
+		name = "bpchar"
+	case "timestamp with time zone":
+		name = "timestamptz"
+	case "timestamp without time zone":
+		name = "timestamp"
+	case "time with time zone":
+		name = "timetz"
+	case "time without time zone":
+		name = "time"
+	case "bit varying":
+		name = "varbit"
+	}
+
+	if array {
+		return "_" + name
+	}
+
+	return name
+}
+
+// formatted the name of the type as the database formats it. i.e.) int4 is integer, _int4 is integer[]
+func formatted(name string) string {
+	base, array := strings.CutPrefix(name, "_")
+	switch base {
+	case "int2":
+		base = "smallint"
+	case "int4":
+		base = "integer"
+	case "int8":
+		base = "bigint"
+	case "float4":
+		base = "real"
+	case "float8":
+		base = "double precision"
+	case "bool":
+		base = "boolean"
+	case "varchar":
+		base = "character varying"
+	case "bpchar":
+		base = "character"
+	case "timestamptz":
+		base = "timestamp with time zone"
+	case "timestamp":
+		base = "timestamp without time zone"
+	case "timetz":
+		base = "time with time zone"
+	case "time":
+		base = "time without time zone"
+	case "varbit":
+		base = "bit varying"
+	}
+
+	if array {
+		return base + "[]"
+	}
+
+	return base
+}
@@ -1,6 +1,7 @@
 package postgresql
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"go/ast"
@@ -19,6 +20,8 @@ import (
 	"github.com/james-lawrence/genieql/internal/debugx"
 	"github.com/james-lawrence/genieql/internal/errorsx"
 	"github.com/james-lawrence/genieql/internal/postgresql/internal"
+	"github.com/james-lawrence/genieql/internal/sqlx"
+	"github.com/james-lawrence/genieql/internal/stringsx"
 	"github.com/james-lawrence/genieql/internal/transformx"
 )
 
@@ -36,6 +39,7 @@ func init() {
 
 type queryer interface {
 	Query(string, ...interface{}) (*sql.Rows, error)
+	QueryRow(string, ...interface{}) *sql.Row
 }
 
 type dialectFactory struct{}
@@ -85,6 +89,34 @@ func (t dialectImplementation) Delete(table string, columns, predicates []string
 	return Delete(table, columns, predicates)
 }
 
+func (t dialectImplementation) UpdateBatch(n int, table string, columns, types, predicates, returning []string) string {
+	return UpdateBatch(n, table, columns, types, predicates, returning)
+}
+
+func (t dialectImplementation) DeleteBatch(n int, table string, columns, types, returning []string) string {
+	return DeleteBatch(n, table, columns, types, returning)
+}
+
+func (t dialectImplementation) Conflict(target, updates []string) string {
+	return Conflict(target, updates)
+}
+
+func (t dialectImplementation) Paginate(table string, columns, keys []string, after bool) string {
+	return Paginate(table, columns, keys, after)
+}
+
+func (t dialectImplementation) Membership(placeholder string) string {
+	return Membership(placeholder)
+}
+
+func (t dialectImplementation) MaxParameters() int {
+	return MaxParameters
+}
+
+func (t dialectImplementation) Returning() bool {
+	return true
+}
+
 func (t dialectImplementation) ColumnValueTransformer() genieql.ColumnTransformer {
 	return NewColumnValueTransformer()
 }
@@ -116,45 +148,62 @@ func (t dialectImplementation) ColumnInformationForQuery(d genieql.Driver, query
 	return columnInformation(d, tx, columnInformationQuery, table)
 }
 
+func (t dialectImplementation) ParameterInformationForQuery(d genieql.Driver, query string) ([]genieql.ColumnInfo, error) {
+	return parameterInformation(d, t.db, query)
+}
+
+func (t dialectImplementation) Validate(query string) error {
+	return sqlx.Validate(t.db, query)
+}
+
 func (t dialectImplementation) QuotedString(s string) string {
 	return quotedString(s)
 }
 
 func columnInformation(d genieql.Driver, q queryer, query, table string) ([]genieql.ColumnInfo, error) {
+	type column struct {
+		name     string
+		oid      int
+		tname    string
+		nullable bool
+		primary  bool
+	}
+
 	var (
 		err     error
 		rows    *sql.Rows
+		found   []column
 		columns []genieql.ColumnInfo
 	)
 
 	if rows, err = q.Query(query, table); err != nil {
 		return nil, errorsx.Wrapf(err, "failed to query column information: %s, %s", query, table)
 	}
-	defer rows.Close()
 
 	for rows.Next() {
-		var (
-			columndef genieql.ColumnDefinition
-			oid       int
-			tname     string
-			expr      ast.Expr
-			primary   bool
-			nullable  bool
-			name      string
-		)
+		var c column
 
-		if err = rows.Scan(&name, &oid, &tname, &nullable, &primary); err != nil {
+		if err = rows.Scan(&c.name, &c.oid, &c.tname, &c.nullable, &c.primary); err != nil {
+			rows.Close()
 			return nil, errorsx.Wrapf(err, "error scanning column information for table (%s): %s", table, query)
 		}
 
-		expr = internal.OIDToType(oid)
-		if expr == nil {
-			log.Println("nonstandard column type", name, "unknown type identifier", oid, "falling back to type name", tname)
-			expr = astutil.Expr(tname)
-		}
+		found = append(found, c)
+	}
+
+	// user defined types are resolved with additional queries, so the rows
+	// must be released before resolving them.
+	if err = errorsx.Compact(rows.Err(), rows.Close()); err != nil {
+		return nil, errorsx.Wrap(err, "error retrieving column information")
+	}
+
+	for _, c := range found {
+		var (
+			columndef genieql.ColumnDefinition
+		)
 
-		if columndef, err = d.LookupType(types.ExprString(expr)); err != nil {
-			log.Println("skipping column", name, "driver missing type", types.ExprString(expr), "please open an issue")
+		if columndef, err = lookupType(d, q, c.oid, c.tname); err != nil {
+			log.Println("skipping column", c.name, err, "please open an issue")
 			continue
 		}
 
@@ -162,20 +211,166 @@ func columnInformation(d genieql.Driver, q queryer, query, table string) ([]geni
 		case "[]byte":
 			columndef.Nullable = false
 		default:
-			columndef.Nullable = nullable
+			columndef.Nullable = c.nullable
 		}
 
-		columndef.PrimaryKey = primary
+		columndef.PrimaryKey = c.primary
+		// the database type is required to bind arrays of the column, i.e.) batch updates.
+		columndef.DBTypeName = stringsx.DefaultIfBlank(columndef.DBTypeName, c.tname)
 
-		debugx.Println("found column", name, types.ExprString(expr), spew.Sdump(columndef))
+		debugx.Println("found column", c.name, c.tname, spew.Sdump(columndef))
 
 		columns = append(columns, genieql.ColumnInfo{
-			Name:       name,
+			Name:       c.name,
 			Definition: columndef,
 		})
 	}
 
-	columns = genieql.SortColumnInfo(columns)(genieql.ByName)
+	return genieql.SortColumnInfo(columns)(genieql.ByName), nil
+}
+
+// lookupType resolves the column definition for the given type. domains resolve
+// to their base type and enumerations are registered with the driver as text along
+// with their values, the golang type is specified by the structure. see genieql.Enum
+func lookupType(d genieql.Driver, q queryer, oid int, tname string) (columndef genieql.ColumnDefinition, err error) {
+	var (
+		expr ast.Expr
+	)
+
+	if expr = internal.OIDToType(oid); expr == nil {
+		expr = astutil.Expr(tname)
+	}
+
+	if columndef, err = d.LookupType(types.ExprString(expr)); err == nil {
+		return columndef, nil
+	}
+
+	ut, cause := userDefinedType(q, oid)
+	if cause != nil {
+		log.Println("nonstandard column type", tname, "unknown type identifier", oid, cause)
+		return columndef, errorsx.Errorf("driver missing type %s", types.ExprString(expr))
+	}
+
+	switch ut.kind {
+	case typeDomain:
+		return lookupType(d, q, ut.base, ut.basename)
+	case typeEnum:
+		if columndef, err = d.LookupType("pgtype.Text"); err != nil {
+			return columndef, errorsx.Wrapf(err, "driver missing type for enumeration %s", ut.name)
+		}
+
+		columndef.Type = ut.name
+		columndef.DBTypeName = ut.name
+		columndef.Enum = ut.labels
+		d.AddColumnDefinitions(columndef)
+
+		return columndef, nil
+	default:
+		return columndef, errorsx.Errorf("driver missing type %s", types.ExprString(expr))
+	}
+}
+
+const (
+	typeDomain = "d"
+	typeEnum   = "e"
+)
+
+type userdefined struct {
+	name     string
+	kind     string
+	base     int
+	basename string
+	labels   []string
+}
+
+// userDefinedType retrieves the details of a type from the catalog.
+func userDefinedType(q queryer, oid int) (ut userdefined, err error) {
+	const (
+		typeQuery = `SELECT t.typname, t.typtype, t.typbasetype, COALESCE(format_type(t.typbasetype, NULL), '') FROM pg_type t WHERE t.oid = $1`
+		enumQuery = `SELECT e.enumlabel FROM pg_enum e WHERE e.enumtypid = $1 ORDER BY e.enumsortorder`
+	)
+
+	var (
+		rows *sql.Rows
+	)
+
+	if err = q.QueryRow(typeQuery, oid).Scan(&ut.name, &ut.kind, &ut.base, &ut.basename); err != nil {
+		return ut, errorsx.Wrapf(err, "failed to query type information: %d", oid)
+	}
+
+	if ut.kind != typeEnum {
+		return ut, nil
+	}
+
+	if rows, err = q.Query(enumQuery, oid); err != nil {
+		return ut, errorsx.Wrapf(err, "failed to query enumeration labels: %s", ut.name)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var label string
+
+		if err = rows.Scan(&label); err != nil {
+			return ut, errorsx.Wrapf(err, "error scanning enumeration labels: %s", ut.name)
+		}
+
+		ut.labels = append(ut.labels, label)
+	}
+
+	return ut, errorsx.Wrap(rows.Err(), "error retrieving enumeration labels")
+}
+
+// parameterInformation describes the query to determine the type of each placeholder.
+func parameterInformation(d genieql.Driver, db *sql.DB, query string) (params []genieql.ColumnInfo, err error) {
+	var (
+		conn *sql.Conn
+		oids []uint32
+	)
+
+	if conn, err = db.Conn(context.Background()); err != nil {
+		return nil, errorsx.Wrap(err, "unable to acquire connection")
+	}
+	defer conn.Close()
+
+	err = conn.Raw(func(dc any) error {
+		pgconn, ok := dc.(*stdlib.Conn)
+		if !ok {
+			return errorsx.Errorf("unable to describe query, expected a pgx connection found %T", dc)
+		}
+
+		sd, cause := pgconn.Conn().Prepare(context.Background(), "", query)
+		if cause != nil {
+			return errorsx.Wrapf(cause, "unable to describe query: %s", query)
+		}
+
+		oids = sd.ParamOIDs
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, oid := range oids {
+		var (
+			tname     string
+			columndef genieql.ColumnDefinition
+		)
+
+		if err = conn.QueryRowContext(context.Background(), "SELECT format_type($1, NULL)", oid).Scan(&tname); err != nil {
+			return nil, errorsx.Wrapf(err, "failed to query type information: %d", oid)
+		}
+
+		// types unknown to the driver, i.e.) untyped placeholders, are left for the caller to skip.
+		if columndef, err = lookupType(d, db, int(oid), tname); err != nil {
+			debugx.Println("unknown parameter type", idx+1, tname, err)
+			columndef = genieql.ColumnDefinition{}
+		}
+
+		params = append(params, genieql.ColumnInfo{
+			Name:       fmt.Sprintf("$%d", idx+1),
+			Definition: columndef,
+		})
+	}
 
-	return columns, errorsx.Wrap(rows.Err(), "error retrieving column information")
+	return params, nil
 }
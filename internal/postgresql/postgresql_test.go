@@ -1,7 +1,11 @@
 package postgresql_test
 
 import (
+	"os"
+	"path/filepath"
+
 	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/dialects"
 
 	"github.com/james-lawrence/genieql/internal/drivers"
 	. "github.com/james-lawrence/genieql/internal/postgresql"
@@ -63,6 +67,22 @@ var _ = Describe("postgresql", func() {
 			)
 		})
 
+		It("should resolve enumerations and domains", func() {
+			info, err := NewDialect(DB).ColumnInformationForTable(driver, "example5")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(genieql.ColumnInfoSet(info).ColumnNames()).To(Equal([]string{"id", "mood", "previous", "rating"}))
+			Expect(info[1].Definition.Override).To(BeEmpty())
+			Expect(info[1].Definition.Enum).To(Equal([]string{"happy", "sad", "not_sure"}))
+			Expect(info[1].Definition.Nullable).To(BeFalse())
+			Expect(info[2].Definition.Enum).To(Equal([]string{"happy", "sad", "not_sure"}))
+			Expect(info[2].Definition.Nullable).To(BeTrue())
+			Expect(info[3].Definition.Native).To(Equal("int"))
+
+			typedef, err := driver.LookupType("mood")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(typedef.Enum).To(Equal([]string{"happy", "sad", "not_sure"}))
+		})
+
 		It("should support insert queries", func() {
 			q := NewDialect(DB).Insert(1, 0, "table", "", []string{"c1", "c2", "c2"}, []string{"c1", "c2", "c2"}, []string{"c1"})
 			Expect(q).To(Equal(`INSERT INTO table ("c1","c2","c2") VALUES (DEFAULT,$1,$2) RETURNING "c1","c2","c2"`))
@@ -83,4 +103,39 @@ var _ = Describe("postgresql", func() {
 			Expect(q).To(Equal(`DELETE FROM table WHERE "c1" = $1 RETURNING "c1","c2","c2"`))
 		})
 	})
+
+	DescribeTable("offline introspection matches the database",
+		func(schema string, table string) {
+			driver := testx.Must(genieql.LookupDriver(drivers.PGX))
+			dir := GinkgoT().TempDir()
+			Expect(os.WriteFile(filepath.Join(dir, "1_schema.sql"), []byte(schema), 0600)).To(Succeed())
+
+			_, err := DB.Exec(schema)
+			Expect(err).ToNot(HaveOccurred())
+
+			offline, err := dialects.LookupDialect(genieql.Configuration{Dialect: Dialect, Migrations: dir})
+			Expect(err).ToNot(HaveOccurred())
+
+			expected, err := NewDialect(DB).ColumnInformationForTable(driver, table)
+			Expect(err).ToNot(HaveOccurred())
+
+			info, err := offline.ColumnInformationForTable(driver, table)
+			Expect(err).ToNot(HaveOccurred())
+			// the database doesn't report the defaults of columns.
+			for i := range info {
+				info[i].Definition.Default = ""
+			}
+			Expect(info).To(Equal(expected))
+		},
+		Entry(
+			"example 1",
+			"CREATE TABLE offline1 (id bigserial PRIMARY KEY, email character varying(255) NOT NULL, created_at timestamp with time zone NOT NULL DEFAULT now(), score double precision, tags text[])",
+			"offline1",
+		),
+		Entry(
+			"composite primary keys",
+			"CREATE TABLE Offline2 (a uuid, b integer, c numeric(10,2) NOT NULL, d boolean, e bytea, CONSTRAINT offline2_pkey PRIMARY KEY (b, a))",
+			"offline2",
+		),
+	)
 })
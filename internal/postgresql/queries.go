@@ -2,6 +2,7 @@ package postgresql
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/james-lawrence/genieql"
@@ -69,6 +70,67 @@ func Delete(table string, columns, predicates []string) string {
 	return fmt.Sprintf(deleteTmpl, table, strings.Join(clauses, " AND "), columnOrder)
 }
 
+// UpdateBatch generate a query updating many rows by joining the table against the arrays of values.
+func UpdateBatch(n int, table string, columns, types, predicates, returning []string) string {
+	return fmt.Sprintf(
+		updateBatchTmpl,
+		table,
+		strings.Join(batchAssignments(columns, predicates), ", "),
+		strings.Join(unnest(types), ", "),
+		strings.Join(quotedColumns(columns...), ","),
+		strings.Join(batchJoin(predicates), " AND "),
+		strings.Join(qualifiedColumns(returning...), ","),
+	)
+}
+
+// DeleteBatch generate a query deleting many rows by joining the table against the arrays of values.
+func DeleteBatch(n int, table string, columns, types, returning []string) string {
+	return fmt.Sprintf(
+		deleteBatchTmpl,
+		table,
+		strings.Join(unnest(types), ", "),
+		strings.Join(quotedColumns(columns...), ","),
+		strings.Join(batchJoin(columns), " AND "),
+		strings.Join(qualifiedColumns(returning...), ","),
+	)
+}
+
+// Conflict generate an upsert clause.
+func Conflict(target, updates []string) string {
+	if len(updates) == 0 {
+		return fmt.Sprintf("ON CONFLICT (%s) DO NOTHING", strings.Join(quotedColumns(target...), ","))
+	}
+
+	assignments := make([]string, 0, len(updates))
+	for _, c := range quotedColumns(updates...) {
+		assignments = append(assignments, fmt.Sprintf("%s = EXCLUDED.%s", c, c))
+	}
+
+	return fmt.Sprintf("ON CONFLICT (%s) DO UPDATE SET %s", strings.Join(quotedColumns(target...), ","), strings.Join(assignments, ", "))
+}
+
+// Paginate generate a keyset pagination query.
+func Paginate(table string, columns, keys []string, after bool) string {
+	columnOrder := strings.Join(quotedColumns(columns...), ",")
+	keyOrder := strings.Join(quotedColumns(keys...), ",")
+
+	if !after {
+		return fmt.Sprintf(paginateTmpl, columnOrder, table, matchAllClause, keyOrder, 1)
+	}
+
+	p, offset := placeholders(1, selectPlaceholder(keys, nil))
+	clause := fmt.Sprintf("(%s) > (%s)", keyOrder, strings.Join(p, ","))
+	return fmt.Sprintf(paginateTmpl, columnOrder, table, clause, keyOrder, offset)
+}
+
+// MaxParameters the wire protocol limits the number of bind parameters to a uint16.
+const MaxParameters = 65535
+
+// Membership generate a clause matching against the array bound to the placeholder.
+func Membership(placeholder string) string {
+	return fmt.Sprintf("= ANY(%s)", placeholder)
+}
+
 func predicate(offset int, predicates ...string) ([]string, int) {
 	clauses := make([]string, 0, len(predicates))
 	for idx, predicate := range quotedColumns(predicates...) {
@@ -82,6 +144,51 @@ func predicate(offset int, predicates ...string) ([]string, int) {
 	return clauses, len(predicates) + 1
 }
 
+// unnest binds an array for each of the types. i.e.) $1::int4[]
+// the types are required, postgresql is unable to infer the type of the arrays.
+func unnest(types []string) []string {
+	arrays := make([]string, 0, len(types))
+	for idx, typ := range types {
+		p, _ := offsetPlaceholder{}.String(idx + 1)
+		arrays = append(arrays, fmt.Sprintf("%s::%s[]", p, typ))
+	}
+
+	return arrays
+}
+
+// batchAssignments updates the columns that are not predicates from the batch values.
+func batchAssignments(columns, predicates []string) []string {
+	assignments := make([]string, 0, len(columns))
+	for _, c := range columns {
+		if slices.Contains(predicates, c) {
+			continue
+		}
+
+		assignments = append(assignments, fmt.Sprintf("%s = v.%s", quotedString(c), quotedString(c)))
+	}
+
+	return assignments
+}
+
+// batchJoin matches the rows of the table against the batch values.
+func batchJoin(predicates []string) []string {
+	clauses := make([]string, 0, len(predicates))
+	for _, c := range quotedColumns(predicates...) {
+		clauses = append(clauses, fmt.Sprintf("t.%s = v.%s", c, c))
+	}
+
+	return clauses
+}
+
+// qualifiedColumns qualifies the columns by the table alias of batch queries.
+func qualifiedColumns(columns ...string) []string {
+	results := make([]string, 0, len(columns))
+	for _, c := range quotedColumns(columns...) {
+		results = append(results, "t."+c)
+	}
+	return results
+}
+
 func placeholders(offset int, columns []placeholder) ([]string, int) {
 	clauses := make([]string, 0, len(columns))
 	idx := offset
@@ -143,4 +250,7 @@ func (t offsetPlaceholder) String(offset int) (string, int) {
 const selectByFieldTmpl = "SELECT %s FROM %s WHERE %s"
 const updateTmpl = "UPDATE %s SET %s WHERE %s RETURNING %s"
 const deleteTmpl = "DELETE FROM %s WHERE %s RETURNING %s"
+const updateBatchTmpl = "UPDATE %s AS t SET %s FROM unnest(%s) AS v(%s) WHERE %s RETURNING %s"
+const deleteBatchTmpl = "DELETE FROM %s AS t USING unnest(%s) AS v(%s) WHERE %s RETURNING %s"
+const paginateTmpl = "SELECT %s FROM %s WHERE %s ORDER BY %s LIMIT $%d"
 const matchAllClause = "'t'"
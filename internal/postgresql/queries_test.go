@@ -46,4 +46,37 @@ var _ = Describe("queries", func() {
 		Entry("example 2", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{"col1", "col2"}, `DELETE FROM MyTable2 WHERE "col1" = $1 AND "col2" = $2 RETURNING "col1","col2","col3","col4"`),
 		Entry("example 3", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{}, `DELETE FROM MyTable2 WHERE 't' RETURNING "col1","col2","col3","col4"`),
 	)
+
+	DescribeTable("UpdateBatch",
+		func(table string, columns, types, predicates []string, query string) {
+			Expect(UpdateBatch(2, table, columns, types, predicates, columns)).To(Equal(query))
+		},
+		Entry("example 1", "MyTable1", []string{"col1", "col2", "col3"}, []string{"int8", "text", "bool"}, []string{"col1"}, `UPDATE MyTable1 AS t SET "col2" = v."col2", "col3" = v."col3" FROM unnest($1::int8[], $2::text[], $3::bool[]) AS v("col1","col2","col3") WHERE t."col1" = v."col1" RETURNING t."col1",t."col2",t."col3"`),
+		Entry("example 2", "MyTable2", []string{"col1", "col2", "col3"}, []string{"uuid", "int4", "text"}, []string{"col1", "col2"}, `UPDATE MyTable2 AS t SET "col3" = v."col3" FROM unnest($1::uuid[], $2::int4[], $3::text[]) AS v("col1","col2","col3") WHERE t."col1" = v."col1" AND t."col2" = v."col2" RETURNING t."col1",t."col2",t."col3"`),
+	)
+
+	DescribeTable("DeleteBatch",
+		func(table string, columns, types, returning []string, query string) {
+			Expect(DeleteBatch(2, table, columns, types, returning)).To(Equal(query))
+		},
+		Entry("example 1", "MyTable1", []string{"col1"}, []string{"int8"}, []string{"col1", "col2"}, `DELETE FROM MyTable1 AS t USING unnest($1::int8[]) AS v("col1") WHERE t."col1" = v."col1" RETURNING t."col1",t."col2"`),
+		Entry("example 2", "MyTable2", []string{"col1", "col2"}, []string{"uuid", "int4"}, []string{"col1", "col2", "col3"}, `DELETE FROM MyTable2 AS t USING unnest($1::uuid[], $2::int4[]) AS v("col1","col2") WHERE t."col1" = v."col1" AND t."col2" = v."col2" RETURNING t."col1",t."col2",t."col3"`),
+	)
+
+	DescribeTable("Conflict",
+		func(target, updates []string, clause string) {
+			Expect(Conflict(target, updates)).To(Equal(clause))
+		},
+		Entry("example 1", []string{"col1"}, []string{}, `ON CONFLICT ("col1") DO NOTHING`),
+		Entry("example 2", []string{"col1", "col2"}, []string{"col3", "col4"}, `ON CONFLICT ("col1","col2") DO UPDATE SET "col3" = EXCLUDED."col3", "col4" = EXCLUDED."col4"`),
+	)
+
+	DescribeTable("Paginate",
+		func(table string, columns, keys []string, after bool, query string) {
+			Expect(Paginate(table, columns, keys, after)).To(Equal(query))
+		},
+		Entry("example 1", "MyTable1", []string{"col1", "col2", "col3"}, []string{"col1"}, false, `SELECT "col1","col2","col3" FROM MyTable1 WHERE 't' ORDER BY "col1" LIMIT $1`),
+		Entry("example 2", "MyTable1", []string{"col1", "col2", "col3"}, []string{"col1"}, true, `SELECT "col1","col2","col3" FROM MyTable1 WHERE ("col1") > ($1) ORDER BY "col1" LIMIT $2`),
+		Entry("example 3", "MyTable2", []string{"col1", "col2", "col3"}, []string{"col2", "col1"}, true, `SELECT "col1","col2","col3" FROM MyTable2 WHERE ("col2","col1") > ($1,$2) ORDER BY "col2","col1" LIMIT $3`),
+	)
 })
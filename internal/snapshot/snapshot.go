@@ -0,0 +1,190 @@
+// Package snapshot records the column information generators consult from the database
+// into a lockfile, allowing generation to replay it without a database connection.
+package snapshot
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/internal/errorsx"
+)
+
+// Snapshot the columns of the tables and queries, and the parameters of the queries,
+// keyed by the table name or query the generators provided.
+type Snapshot struct {
+	Tables     map[string][]genieql.ColumnInfo `yaml:"tables,omitempty"`
+	Queries    map[string][]genieql.ColumnInfo `yaml:"queries,omitempty"`
+	Parameters map[string][]genieql.ColumnInfo `yaml:"parameters,omitempty"`
+}
+
+// Read the snapshot from the path.
+func Read(path string) (s Snapshot, err error) {
+	var (
+		raw []byte
+	)
+
+	if raw, err = os.ReadFile(path); err != nil {
+		return s, errorsx.Wrapf(err, "unable to read schema snapshot: %s", path)
+	}
+
+	if err = yaml.Unmarshal(raw, &s); err != nil {
+		return s, errorsx.Wrapf(err, "unable to parse schema snapshot: %s", path)
+	}
+
+	return s, nil
+}
+
+// Write the snapshot to the path, replacing any previous snapshot.
+func Write(path string, s Snapshot) (err error) {
+	var (
+		raw []byte
+	)
+
+	if raw, err = yaml.Marshal(s); err != nil {
+		return errorsx.Wrap(err, "failed to serialize schema snapshot to yaml")
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return errorsx.Wrap(err, "failed to create schema snapshot")
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(raw); err != nil {
+		return errorsx.Compact(errorsx.Wrap(err, "failed to write schema snapshot"), tmp.Close())
+	}
+
+	if err = tmp.Close(); err != nil {
+		return errorsx.Wrap(err, "failed to write schema snapshot")
+	}
+
+	return errorsx.Wrap(os.Rename(tmp.Name(), path), "failed to persist schema snapshot")
+}
+
+// NewRecorder records the results of the dialects it wraps.
+func NewRecorder() *Recorder {
+	return &Recorder{
+		s: Snapshot{
+			Tables:     map[string][]genieql.ColumnInfo{},
+			Queries:    map[string][]genieql.ColumnInfo{},
+			Parameters: map[string][]genieql.ColumnInfo{},
+		},
+	}
+}
+
+// Recorder accumulates the column information consulted through its dialects,
+// safe for concurrent use by many generators.
+type Recorder struct {
+	m sync.Mutex
+	s Snapshot
+}
+
+// Dialect records the column information retrieved from the dialect.
+func (t *Recorder) Dialect(d genieql.Dialect) genieql.Dialect {
+	return recorder{Dialect: d, r: t}
+}
+
+// Snapshot of the column information recorded so far.
+func (t *Recorder) Snapshot() Snapshot {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	return Snapshot{
+		Tables:     maps.Clone(t.s.Tables),
+		Queries:    maps.Clone(t.s.Queries),
+		Parameters: maps.Clone(t.s.Parameters),
+	}
+}
+
+func (t *Recorder) record(m map[string][]genieql.ColumnInfo, key string, columns []genieql.ColumnInfo, err error) ([]genieql.ColumnInfo, error) {
+	if err != nil {
+		return columns, err
+	}
+
+	t.m.Lock()
+	defer t.m.Unlock()
+	m[key] = columns
+
+	return columns, nil
+}
+
+type recorder struct {
+	genieql.Dialect
+	r *Recorder
+}
+
+func (t recorder) ColumnInformationForTable(d genieql.Driver, table string) ([]genieql.ColumnInfo, error) {
+	columns, err := t.Dialect.ColumnInformationForTable(d, table)
+	return t.r.record(t.r.s.Tables, table, columns, err)
+}
+
+func (t recorder) ColumnInformationForQuery(d genieql.Driver, query string) ([]genieql.ColumnInfo, error) {
+	columns, err := t.Dialect.ColumnInformationForQuery(d, query)
+	return t.r.record(t.r.s.Queries, query, columns, err)
+}
+
+func (t recorder) ParameterInformationForQuery(d genieql.Driver, query string) ([]genieql.ColumnInfo, error) {
+	params, err := t.Dialect.ParameterInformationForQuery(d, query)
+	return t.r.record(t.r.s.Parameters, query, params, err)
+}
+
+// NewDialect replays the snapshot, queries are generated by the dialect.
+// tables and queries missing from the snapshot are errors.
+func NewDialect(d genieql.Dialect, s Snapshot) genieql.Dialect {
+	return replay{Dialect: d, s: s}
+}
+
+type replay struct {
+	genieql.Dialect
+	s Snapshot
+}
+
+func (t replay) ColumnInformationForTable(d genieql.Driver, table string) ([]genieql.ColumnInfo, error) {
+	columns, ok := t.s.Tables[table]
+	if !ok {
+		return nil, errorsx.Errorf("table %s is missing from the schema snapshot, run genieql schema snapshot to update it", table)
+	}
+
+	return register(d, columns), nil
+}
+
+func (t replay) ColumnInformationForQuery(d genieql.Driver, query string) ([]genieql.ColumnInfo, error) {
+	columns, ok := t.s.Queries[query]
+	if !ok {
+		return nil, errorsx.Errorf("query is missing from the schema snapshot, run genieql schema snapshot to update it: %s", query)
+	}
+
+	return register(d, columns), nil
+}
+
+// ParameterInformationForQuery queries missing from the snapshot have no parameter information.
+func (t replay) ParameterInformationForQuery(d genieql.Driver, query string) ([]genieql.ColumnInfo, error) {
+	return register(d, t.s.Parameters[query]), nil
+}
+
+// Validate queries can't be prepared without a database.
+func (t replay) Validate(query string) error {
+	return errorsx.Errorf("unable to validate queries from a schema snapshot, a database connection is required: %s", query)
+}
+
+// register the enumerations with the driver, the dialect registers them when resolving
+// the columns from the database.
+func register(d genieql.Driver, columns []genieql.ColumnInfo) []genieql.ColumnInfo {
+	for _, c := range columns {
+		if len(c.Definition.Enum) == 0 {
+			continue
+		}
+
+		// the nullability and keys are properties of the column, not the type.
+		typedef := c.Definition
+		typedef.Nullable, typedef.PrimaryKey, typedef.Default, typedef.Generated = false, false, "", false
+		d.AddColumnDefinitions(typedef)
+	}
+
+	return columns
+}
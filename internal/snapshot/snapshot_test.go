@@ -0,0 +1,61 @@
+package snapshot_test
+
+import (
+	"path/filepath"
+
+	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/dialects"
+	"github.com/james-lawrence/genieql/internal/drivers"
+	. "github.com/james-lawrence/genieql/internal/snapshot"
+	"github.com/james-lawrence/genieql/internal/testx"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("snapshot", func() {
+	var (
+		driver = testx.Must(genieql.LookupDriver(drivers.StandardLib))
+		live   = dialects.Test{
+			ParameterTypes: func(d genieql.Driver, query string) ([]genieql.ColumnInfo, error) {
+				return []genieql.ColumnInfo{{Name: "a", Definition: testx.Must(d.LookupType("int"))}}, nil
+			},
+		}
+	)
+
+	It("should replay the recorded columns", func() {
+		recorder := NewRecorder()
+		recording := recorder.Dialect(live)
+
+		tables := map[string][]genieql.ColumnInfo{}
+		for _, table := range []string{"struct_a", "struct_a_pk"} {
+			tables[table] = testx.Must(recording.ColumnInformationForTable(driver, table))
+		}
+		params := testx.Must(recording.ParameterInformationForQuery(driver, "SELECT $1"))
+
+		path := filepath.Join(GinkgoT().TempDir(), "default.config.lock")
+		Expect(Write(path, recorder.Snapshot())).To(Succeed())
+
+		replay := NewDialect(live, testx.Must(Read(path)))
+		for table, expected := range tables {
+			Expect(replay.ColumnInformationForTable(driver, table)).To(Equal(expected))
+		}
+		Expect(replay.ParameterInformationForQuery(driver, "SELECT $1")).To(Equal(params))
+		Expect(replay.Select("struct_a", []string{"a"}, []string{"a"})).To(Equal(live.Select("struct_a", []string{"a"}, []string{"a"})))
+	})
+
+	It("should reject tables and queries missing from the snapshot", func() {
+		replay := NewDialect(live, NewRecorder().Snapshot())
+
+		_, err := replay.ColumnInformationForTable(driver, "struct_a")
+		Expect(err).To(MatchError(ContainSubstring("table struct_a is missing from the schema snapshot")))
+		_, err = replay.ColumnInformationForQuery(driver, "SELECT 1")
+		Expect(err).To(MatchError(ContainSubstring("query is missing from the schema snapshot")))
+		Expect(replay.ParameterInformationForQuery(driver, "SELECT 1")).To(BeEmpty())
+	})
+
+	It("should reject validating queries without a database", func() {
+		replay := NewDialect(live, NewRecorder().Snapshot())
+		Expect(replay.Validate("SELECT 1")).To(MatchError(ContainSubstring("a database connection is required")))
+	})
+})
@@ -2,29 +2,58 @@ package sqlite3
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/james-lawrence/genieql"
 	"github.com/james-lawrence/genieql/internal/stringsx"
 )
 
-// Insert generate an insert query.
-func Insert(n int, offset int, table, conflict string, columns, defaulted []string) string {
+type columnValueTransformer struct {
+	offset int
+}
+
+func (t *columnValueTransformer) Transform(c genieql.ColumnInfo) string {
+	t.offset++
+	p, _ := offsetPlaceholder{}.String(t.offset)
+	return p
+}
+
+// Insert generate an insert query. sqlite does not support DEFAULT within VALUES,
+// defaulted columns are omitted from the query instead. when every column is defaulted
+// a single row is inserted regardless of n.
+func Insert(n int, offset int, table, conflict string, columns, projection, defaulted []string) string {
 	const (
-		insertTmpl = "INSERT INTO :gql.insert.tablename: (:gql.insert.columns:) VALUES (:gql.insert.values:):gql.insert.conflict:"
+		insertTmpl  = "INSERT INTO :gql.insert.tablename: (:gql.insert.columns:) VALUES :gql.insert.values::gql.insert.conflict::gql.insert.returning:"
+		defaultTmpl = "INSERT INTO :gql.insert.tablename: DEFAULT VALUES:gql.insert.conflict::gql.insert.returning:"
 	)
+
+	columns = slices.DeleteFunc(slices.Clone(columns), func(c string) bool {
+		return slices.Contains(defaulted, c)
+	})
+
 	offset = offset + 1
-	p, _ := placeholders(offset, selectPlaceholder(columns, defaulted))
-	values := strings.Join(p, ",")
-	columnOrder := strings.Join(columns, ",")
+	values := make([]string, 0, n)
+	for range n {
+		var (
+			p []string
+		)
+		p, offset = placeholders(offset, selectPlaceholder(columns, nil))
+		values = append(values, fmt.Sprintf("(%s)", strings.Join(p, ",")))
+	}
 
 	replacements := strings.NewReplacer(
 		":gql.insert.tablename:", table,
-		":gql.insert.columns:", columnOrder,
-		":gql.insert.values:", values,
+		":gql.insert.columns:", strings.Join(columns, ","),
+		":gql.insert.values:", strings.Join(values, ","),
 		":gql.insert.conflict:", stringsx.DefaultIfBlank(" "+conflict, ""),
-		":gql.insert.returning:", columnOrder,
+		":gql.insert.returning:", returning(projection),
 	)
 
+	if len(columns) == 0 {
+		return replacements.Replace(defaultTmpl)
+	}
+
 	return replacements.Replace(insertTmpl)
 }
 
@@ -36,16 +65,119 @@ func Select(table string, columns, predicates []string) string {
 }
 
 // Update generate an update query.
-func Update(table string, columns, predicates []string) string {
+func Update(table string, columns, predicates, projection []string) string {
 	updates, offset := predicate(1, columns...)
 	clauses, _ := predicate(offset, predicates...)
-	return fmt.Sprintf(updateTmpl, table, strings.Join(updates, ", "), strings.Join(clauses, " AND "))
+	return fmt.Sprintf(updateTmpl, table, strings.Join(updates, ", "), strings.Join(clauses, " AND "), returning(projection))
 }
 
 // Delete generate a delete query.
 func Delete(table string, columns, predicates []string) string {
 	clauses, _ := predicate(1, predicates...)
-	return fmt.Sprintf(deleteTmpl, table, strings.Join(clauses, " AND "))
+	return fmt.Sprintf(deleteTmpl, table, strings.Join(clauses, " AND "), returning(columns))
+}
+
+// UpdateBatch generate a query updating many rows by joining the table against a VALUES
+// common table expression.
+func UpdateBatch(n int, table string, columns, types, predicates, returning []string) string {
+	assignments := make([]string, 0, len(columns))
+	for _, c := range columns {
+		if slices.Contains(predicates, c) {
+			continue
+		}
+
+		assignments = append(assignments, fmt.Sprintf("%s = v.%s", c, c))
+	}
+
+	clauses := make([]string, 0, len(predicates))
+	for _, c := range predicates {
+		clauses = append(clauses, fmt.Sprintf("%s.%s = v.%s", table, c, c))
+	}
+
+	return fmt.Sprintf(
+		updateBatchTmpl,
+		strings.Join(columns, ","),
+		strings.Join(batchValues(n, len(columns)), ","),
+		table,
+		strings.Join(assignments, ", "),
+		strings.Join(clauses, " AND "),
+		strings.Join(returning, ","),
+	)
+}
+
+// DeleteBatch generate a query deleting many rows matching a VALUES common table expression.
+func DeleteBatch(n int, table string, columns, types, returning []string) string {
+	columnOrder := strings.Join(columns, ",")
+	return fmt.Sprintf(
+		deleteBatchTmpl,
+		columnOrder,
+		strings.Join(batchValues(n, len(columns)), ","),
+		table,
+		columnOrder,
+		columnOrder,
+		strings.Join(returning, ","),
+	)
+}
+
+// Conflict generate an upsert clause.
+func Conflict(target, updates []string) string {
+	if len(updates) == 0 {
+		return fmt.Sprintf("ON CONFLICT (%s) DO NOTHING", strings.Join(target, ","))
+	}
+
+	assignments := make([]string, 0, len(updates))
+	for _, c := range updates {
+		assignments = append(assignments, fmt.Sprintf("%s = excluded.%s", c, c))
+	}
+
+	return fmt.Sprintf("ON CONFLICT (%s) DO UPDATE SET %s", strings.Join(target, ","), strings.Join(assignments, ", "))
+}
+
+// Paginate generate a keyset pagination query.
+func Paginate(table string, columns, keys []string, after bool) string {
+	columnOrder := strings.Join(columns, ",")
+	keyOrder := strings.Join(keys, ",")
+
+	if !after {
+		return fmt.Sprintf(paginateTmpl, columnOrder, table, matchAllClause, keyOrder, 1)
+	}
+
+	p, _ := placeholders(1, selectPlaceholder(keys, nil))
+	clause := fmt.Sprintf("(%s) > (%s)", keyOrder, strings.Join(p, ","))
+	return fmt.Sprintf(paginateTmpl, columnOrder, table, clause, keyOrder, len(keys)+1)
+}
+
+// MaxParameters the default value of SQLITE_MAX_VARIABLE_NUMBER since 3.32.0.
+const MaxParameters = 32766
+
+// Membership sqlite is unable to bind arrays, the values are expanded at runtime.
+func Membership(placeholder string) string {
+	return ""
+}
+
+// returning generates the clause projecting the columns of the modified rows,
+// supported since sqlite 3.35.0. empty when there are no columns.
+func returning(columns []string) string {
+	if len(columns) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(" RETURNING %s", strings.Join(columns, ","))
+}
+
+// batchValues generates n tuples of placeholders for the columns.
+func batchValues(n int, columns int) []string {
+	values := make([]string, 0, n)
+	for i := range n {
+		p := make([]string, 0, columns)
+		for j := range columns {
+			ph, _ := offsetPlaceholder{}.String(i*columns + j + 1)
+			p = append(p, ph)
+		}
+		values = append(values, fmt.Sprintf("(%s)", strings.Join(p, ",")))
+	}
+
+	return values
 }
 
 func predicate(offset int, predicates ...string) ([]string, int) {
@@ -70,7 +202,7 @@ func placeholders(offset int, columns []placeholder) ([]string, int) {
 		clauses = append(clauses, ph)
 	}
 
-	return clauses, len(clauses)
+	return clauses, idx
 }
 
 func selectPlaceholder(columns, defaults []string) []placeholder {
@@ -107,6 +239,9 @@ func (t offsetPlaceholder) String(offset int) (string, int) {
 }
 
 const selectByFieldTmpl = "SELECT %s FROM %s WHERE %s"
-const updateTmpl = "UPDATE %s SET %s WHERE %s"
-const deleteTmpl = "DELETE FROM %s WHERE %s"
+const updateTmpl = "UPDATE %s SET %s WHERE %s%s"
+const deleteTmpl = "DELETE FROM %s WHERE %s%s"
+const updateBatchTmpl = "WITH v(%s) AS (VALUES %s) UPDATE %s SET %s FROM v WHERE %s RETURNING %s"
+const deleteBatchTmpl = "WITH v(%s) AS (VALUES %s) DELETE FROM %s WHERE (%s) IN (SELECT %s FROM v) RETURNING %s"
+const paginateTmpl = "SELECT %s FROM %s WHERE %s ORDER BY %s LIMIT $%d"
 const matchAllClause = "'t'"
@@ -16,11 +16,21 @@ import (
 var _ = Describe("queries", func() {
 	DescribeTable("Insert",
 		func(table string, columns, defaults []string, query string) {
-			Expect(Insert(1, 0, table, "", columns, defaults)).To(Equal(query))
+			Expect(Insert(1, 0, table, "", columns, nil, defaults)).To(Equal(query))
 		},
 		Entry("example 1", "MyTable1", []string{"col1", "col2", "col3"}, []string{}, "INSERT INTO MyTable1 (col1,col2,col3) VALUES ($1,$2,$3)"),
-		Entry("example 2", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{"col4"}, "INSERT INTO MyTable2 (col1,col2,col3,col4) VALUES ($1,$2,$3,DEFAULT)"),
-		Entry("example 3", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{"col1", "col3"}, "INSERT INTO MyTable2 (col1,col2,col3,col4) VALUES (DEFAULT,$1,DEFAULT,$2)"),
+		Entry("example 2", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{"col4"}, "INSERT INTO MyTable2 (col1,col2,col3) VALUES ($1,$2,$3)"),
+		Entry("example 3", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{"col1", "col3"}, "INSERT INTO MyTable2 (col2,col4) VALUES ($1,$2)"),
+		Entry("example 4", "MyTable3", []string{"col1"}, []string{"col1"}, "INSERT INTO MyTable3 DEFAULT VALUES"),
+	)
+
+	DescribeTable("Insert batches returning the rows",
+		func(n int, table string, columns, projection, defaults []string, query string) {
+			Expect(Insert(n, 0, table, "", columns, projection, defaults)).To(Equal(query))
+		},
+		Entry("example 1", 2, "MyTable1", []string{"col1", "col2"}, []string{"col1", "col2"}, []string{}, "INSERT INTO MyTable1 (col1,col2) VALUES ($1,$2),($3,$4) RETURNING col1,col2"),
+		Entry("example 2", 3, "MyTable2", []string{"col1", "col2", "col3"}, []string{"col1", "col2", "col3"}, []string{"col1"}, "INSERT INTO MyTable2 (col2,col3) VALUES ($1,$2),($3,$4),($5,$6) RETURNING col1,col2,col3"),
+		Entry("example 3", 2, "MyTable3", []string{"col1"}, []string{"col1"}, []string{"col1"}, "INSERT INTO MyTable3 DEFAULT VALUES RETURNING col1"),
 	)
 
 	DescribeTable("Select",
@@ -33,20 +43,61 @@ var _ = Describe("queries", func() {
 
 	DescribeTable("Update",
 		func(table string, columns, predicates []string, query string) {
-			Expect(Update(table, columns, predicates)).To(Equal(query))
+			Expect(Update(table, columns, predicates, nil)).To(Equal(query))
 		},
 		Entry("example 1", "MyTable1", []string{"col1", "col2", "col3"}, []string{"col1"}, "UPDATE MyTable1 SET col1 = $1, col2 = $2, col3 = $3 WHERE col1 = $4"),
 		Entry("example 2", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{"col1", "col2"}, "UPDATE MyTable2 SET col1 = $1, col2 = $2, col3 = $3, col4 = $4 WHERE col1 = $5 AND col2 = $6"),
 		Entry("example 3", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{}, "UPDATE MyTable2 SET col1 = $1, col2 = $2, col3 = $3, col4 = $4 WHERE 't'"),
+		Entry("example 4", "MyTable1", []string{"col2"}, []string{"col1"}, "UPDATE MyTable1 SET col2 = $1 WHERE col1 = $2"),
+	)
+
+	DescribeTable("Update returning the rows",
+		func(table string, columns, predicates, projection []string, query string) {
+			Expect(Update(table, columns, predicates, projection)).To(Equal(query))
+		},
+		Entry("example 1", "MyTable1", []string{"col2"}, []string{"col1"}, []string{"col1", "col2"}, "UPDATE MyTable1 SET col2 = $1 WHERE col1 = $2 RETURNING col1,col2"),
 	)
 
 	DescribeTable("Delete",
 		func(table string, columns, predicates []string, query string) {
 			Expect(Delete(table, columns, predicates)).To(Equal(query))
 		},
-		Entry("example 1", "MyTable1", []string{"col1", "col2", "col3"}, []string{"col1"}, "DELETE FROM MyTable1 WHERE col1 = $1"),
-		Entry("example 2", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{"col1", "col2"}, "DELETE FROM MyTable2 WHERE col1 = $1 AND col2 = $2"),
-		Entry("example 3", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{}, "DELETE FROM MyTable2 WHERE 't'"),
+		Entry("example 1", "MyTable1", []string{"col1", "col2", "col3"}, []string{"col1"}, "DELETE FROM MyTable1 WHERE col1 = $1 RETURNING col1,col2,col3"),
+		Entry("example 2", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{"col1", "col2"}, "DELETE FROM MyTable2 WHERE col1 = $1 AND col2 = $2 RETURNING col1,col2,col3,col4"),
+		Entry("example 3", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{}, "DELETE FROM MyTable2 WHERE 't' RETURNING col1,col2,col3,col4"),
+		Entry("example 4", "MyTable2", []string{}, []string{"col1"}, "DELETE FROM MyTable2 WHERE col1 = $1"),
+	)
+
+	DescribeTable("UpdateBatch",
+		func(n int, table string, columns, predicates []string, query string) {
+			Expect(UpdateBatch(n, table, columns, nil, predicates, columns)).To(Equal(query))
+		},
+		Entry("example 1", 2, "MyTable1", []string{"col1", "col2", "col3"}, []string{"col1"}, "WITH v(col1,col2,col3) AS (VALUES ($1,$2,$3),($4,$5,$6)) UPDATE MyTable1 SET col2 = v.col2, col3 = v.col3 FROM v WHERE MyTable1.col1 = v.col1 RETURNING col1,col2,col3"),
+		Entry("example 2", 1, "MyTable2", []string{"col1", "col2", "col3"}, []string{"col1", "col2"}, "WITH v(col1,col2,col3) AS (VALUES ($1,$2,$3)) UPDATE MyTable2 SET col3 = v.col3 FROM v WHERE MyTable2.col1 = v.col1 AND MyTable2.col2 = v.col2 RETURNING col1,col2,col3"),
+	)
+
+	DescribeTable("DeleteBatch",
+		func(n int, table string, columns, returning []string, query string) {
+			Expect(DeleteBatch(n, table, columns, nil, returning)).To(Equal(query))
+		},
+		Entry("example 1", 2, "MyTable1", []string{"col1"}, []string{"col1", "col2"}, "WITH v(col1) AS (VALUES ($1),($2)) DELETE FROM MyTable1 WHERE (col1) IN (SELECT col1 FROM v) RETURNING col1,col2"),
+		Entry("example 2", 2, "MyTable2", []string{"col1", "col2"}, []string{"col1", "col2", "col3"}, "WITH v(col1,col2) AS (VALUES ($1,$2),($3,$4)) DELETE FROM MyTable2 WHERE (col1,col2) IN (SELECT col1,col2 FROM v) RETURNING col1,col2,col3"),
+	)
+
+	DescribeTable("Conflict",
+		func(target, updates []string, clause string) {
+			Expect(Conflict(target, updates)).To(Equal(clause))
+		},
+		Entry("example 1", []string{"col1"}, []string{}, "ON CONFLICT (col1) DO NOTHING"),
+		Entry("example 2", []string{"col1", "col2"}, []string{"col3", "col4"}, "ON CONFLICT (col1,col2) DO UPDATE SET col3 = excluded.col3, col4 = excluded.col4"),
+	)
+
+	DescribeTable("Paginate",
+		func(table string, columns, keys []string, after bool, query string) {
+			Expect(Paginate(table, columns, keys, after)).To(Equal(query))
+		},
+		Entry("example 1", "MyTable1", []string{"col1", "col2", "col3"}, []string{"col1"}, false, "SELECT col1,col2,col3 FROM MyTable1 WHERE 't' ORDER BY col1 LIMIT $1"),
+		Entry("example 2", "MyTable2", []string{"col1", "col2", "col3"}, []string{"col2", "col1"}, true, "SELECT col1,col2,col3 FROM MyTable2 WHERE (col2,col1) > ($1,$2) ORDER BY col2,col1 LIMIT $3"),
 	)
 
 	Describe("queries should be valid", func() {
@@ -90,7 +141,7 @@ var _ = Describe("queries", func() {
 				id    int
 			)
 
-			query = Insert(1, 0, "example", "", []string{"id", "name"}, []string{})
+			query = Insert(1, 0, "example", "", []string{"id", "name"}, nil, []string{})
 			_, err = db.Exec(query, 1, "foo")
 			Expect(err).ToNot(HaveOccurred())
 			_, err = db.Exec(query, 2, "bar")
@@ -100,6 +151,105 @@ var _ = Describe("queries", func() {
 			Expect(id).To(Equal(1))
 		})
 
+		It("should be able to insert in batches returning the rows", func() {
+			var (
+				ids []int
+			)
+
+			query := Insert(2, 0, "example", "", []string{"id", "name"}, []string{"id"}, []string{})
+			rows, err := db.Query(query, 1, "foo", 2, "bar")
+			Expect(err).ToNot(HaveOccurred())
+			defer rows.Close()
+
+			for rows.Next() {
+				var id int
+				Expect(rows.Scan(&id)).To(Succeed())
+				ids = append(ids, id)
+			}
+
+			Expect(rows.Err()).ToNot(HaveOccurred())
+			Expect(ids).To(ConsistOf(1, 2))
+		})
+
+		It("should be able to update in batches returning the rows", func() {
+			var (
+				err   error
+				names []string
+			)
+
+			query := Insert(1, 0, "example", "", []string{"id", "name"}, nil, []string{})
+			for _, id := range []int{1, 2, 3} {
+				_, err = db.Exec(query, id, "foo")
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			rows, err := db.Query(UpdateBatch(2, "example", []string{"id", "name"}, nil, []string{"id"}, []string{"name"}), 1, "bar", 3, "baz")
+			Expect(err).ToNot(HaveOccurred())
+			defer rows.Close()
+
+			for rows.Next() {
+				var name string
+				Expect(rows.Scan(&name)).To(Succeed())
+				names = append(names, name)
+			}
+
+			Expect(rows.Err()).ToNot(HaveOccurred())
+			Expect(names).To(ConsistOf("bar", "baz"))
+		})
+
+		It("should be able to delete in batches returning the rows", func() {
+			var (
+				err error
+				ids []int
+			)
+
+			query := Insert(1, 0, "example", "", []string{"id", "name"}, nil, []string{})
+			for _, id := range []int{1, 2, 3} {
+				_, err = db.Exec(query, id, "foo")
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			rows, err := db.Query(DeleteBatch(2, "example", []string{"id"}, nil, []string{"id"}), 1, 3)
+			Expect(err).ToNot(HaveOccurred())
+			defer rows.Close()
+
+			for rows.Next() {
+				var id int
+				Expect(rows.Scan(&id)).To(Succeed())
+				ids = append(ids, id)
+			}
+
+			Expect(rows.Err()).ToNot(HaveOccurred())
+			Expect(ids).To(ConsistOf(1, 3))
+		})
+
+		It("should be able to paginate", func() {
+			var (
+				err   error
+				query string
+				ids   []int
+			)
+
+			query = Insert(1, 0, "example", "", []string{"id", "name"}, nil, []string{})
+			for _, id := range []int{1, 2, 3} {
+				_, err = db.Exec(query, id, "foo")
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			rows, err := db.Query(Paginate("example", []string{"id"}, []string{"name", "id"}, true), "foo", 1, 10)
+			Expect(err).ToNot(HaveOccurred())
+			defer rows.Close()
+
+			for rows.Next() {
+				var id int
+				Expect(rows.Scan(&id)).To(Succeed())
+				ids = append(ids, id)
+			}
+
+			Expect(rows.Err()).ToNot(HaveOccurred())
+			Expect(ids).To(Equal([]int{2, 3}))
+		})
+
 		It("should be able to update", func() {
 			var (
 				err   error
@@ -107,7 +257,7 @@ var _ = Describe("queries", func() {
 				name  string
 			)
 
-			query = Insert(1, 0, "example", "", []string{"id", "name"}, []string{})
+			query = Insert(1, 0, "example", "", []string{"id", "name"}, nil, []string{})
 			_, err = db.Exec(query, 1, "foo")
 			Expect(err).ToNot(HaveOccurred())
 
@@ -115,7 +265,7 @@ var _ = Describe("queries", func() {
 			Expect(db.QueryRow(query, 1).Scan(&name)).ToNot(HaveOccurred())
 			Expect(name).To(Equal("foo"))
 
-			query = Update("example", []string{"name"}, []string{"id"})
+			query = Update("example", []string{"name"}, []string{"id"}, nil)
 			_, err = db.Exec(query, "bar", 1)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -131,7 +281,7 @@ var _ = Describe("queries", func() {
 				id    int
 			)
 
-			query = Insert(1, 0, "example", "", []string{"id", "name"}, []string{})
+			query = Insert(1, 0, "example", "", []string{"id", "name"}, nil, []string{})
 			_, err = db.Exec(query, 1, "foo")
 			Expect(err).ToNot(HaveOccurred())
 
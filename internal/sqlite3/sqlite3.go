@@ -4,6 +4,8 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"slices"
+	"strings"
 
 	"github.com/davecgh/go-spew/spew"
 	"golang.org/x/text/transform"
@@ -11,8 +13,11 @@ import (
 	"github.com/james-lawrence/genieql"
 	"github.com/james-lawrence/genieql/columninfo"
 	"github.com/james-lawrence/genieql/dialects"
+	"github.com/james-lawrence/genieql/internal/ddl"
 	"github.com/james-lawrence/genieql/internal/debugx"
+	"github.com/james-lawrence/genieql/internal/drivers"
 	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/sqlx"
 )
 
 // Dialect constant representing the dialect name.
@@ -39,8 +44,31 @@ func (t dialectFactory) Connect(config genieql.Configuration) (genieql.Dialect,
 		db  *sql.DB
 	)
 
-	db, err = sql.Open(config.Dialect, config.ConnectionURL)
-	return dialectImplementation{db: db}, errorsx.Wrap(err, "failure to open database connection")
+	if db, err = sql.Open(driverName(config.Driver), config.Database); err != nil {
+		return nil, errorsx.Wrapf(err, "unable to open sqlite database: %s", config.Database)
+	}
+
+	return dialectImplementation{db: db}, errorsx.Wrapf(db.Ping(), "unable to connect to sqlite database: %s", config.Database)
+}
+
+// driverName the database/sql driver of the configured driver, the pure go driver
+// (modernc.org/sqlite) registers itself as sqlite and the cgo driver as sqlite3.
+func driverName(driver string) string {
+	if driver == drivers.SQLite {
+		return "sqlite"
+	}
+
+	return Dialect
+}
+
+// Offline describes the tables using the DDL migrations of the configuration.
+func (t dialectFactory) Offline(config genieql.Configuration) (genieql.Dialect, error) {
+	schema, err := ddl.ReadDir(config.MigrationsDirectory())
+	if err != nil {
+		return nil, err
+	}
+
+	return ddl.NewDialect(dialectImplementation{}, schema, lookupType, ddl.KeysFirst), nil
 }
 
 type dialectImplementation struct {
@@ -48,7 +76,7 @@ type dialectImplementation struct {
 }
 
 func (t dialectImplementation) Insert(n int, offset int, table, conflict string, columns, projection, defaults []string) string {
-	return Insert(n, offset, table, conflict, columns, defaults)
+	return Insert(n, offset, table, conflict, columns, projection, defaults)
 }
 
 func (t dialectImplementation) Select(table string, columns, predicates []string) string {
@@ -56,16 +84,43 @@ func (t dialectImplementation) Select(table string, columns, predicates []string
 }
 
 func (t dialectImplementation) Update(table string, columns, predicates, returning []string) string {
-	return Update(table, columns, predicates)
+	return Update(table, columns, predicates, returning)
 }
 
 func (t dialectImplementation) Delete(table string, columns, predicates []string) string {
 	return Delete(table, columns, predicates)
 }
 
+func (t dialectImplementation) UpdateBatch(n int, table string, columns, types, predicates, returning []string) string {
+	return UpdateBatch(n, table, columns, types, predicates, returning)
+}
+
+func (t dialectImplementation) DeleteBatch(n int, table string, columns, types, returning []string) string {
+	return DeleteBatch(n, table, columns, types, returning)
+}
+
+func (t dialectImplementation) Conflict(target, updates []string) string {
+	return Conflict(target, updates)
+}
+
+func (t dialectImplementation) Paginate(table string, columns, keys []string, after bool) string {
+	return Paginate(table, columns, keys, after)
+}
+
+func (t dialectImplementation) Membership(placeholder string) string {
+	return Membership(placeholder)
+}
+
+func (t dialectImplementation) MaxParameters() int {
+	return MaxParameters
+}
+
+func (t dialectImplementation) Returning() bool {
+	return true
+}
+
 func (t dialectImplementation) ColumnValueTransformer() genieql.ColumnTransformer {
-	// TODO
-	return columninfo.NewNameTransformer(transform.Nop)
+	return &columnValueTransformer{}
 }
 
 func (t dialectImplementation) ColumnNameTransformer(opts ...transform.Transformer) genieql.ColumnTransformer {
@@ -74,83 +129,187 @@ func (t dialectImplementation) ColumnNameTransformer(opts ...transform.Transform
 }
 
 func (t dialectImplementation) ColumnInformationForTable(d genieql.Driver, table string) ([]genieql.ColumnInfo, error) {
-	const columnInformationQuery = `PRAGMA table_info('%s')`
+	const columnInformationQuery = `SELECT name, type, "notnull", pk, hidden FROM pragma_table_xinfo($1) ORDER BY cid`
 	return columnInformation(d, t.db, columnInformationQuery, table)
 }
 
-func (t dialectImplementation) ColumnInformationForQuery(d genieql.Driver, query string) ([]genieql.ColumnInfo, error) {
-	const columnInformationQuery = `PRAGMA table_info('%s')`
+func (t dialectImplementation) ColumnInformationForQuery(d genieql.Driver, query string) (_ []genieql.ColumnInfo, err error) {
+	const columnInformationQuery = `SELECT name, type, "notnull", pk, hidden FROM pragma_table_xinfo($1, 'temp') ORDER BY cid`
 	const table = "genieql_query_columns_table"
 
 	tx, err := t.db.Begin()
 	if err != nil {
 		return nil, errorsx.Wrap(err, "failure to start transaction")
 	}
-	defer tx.Rollback()
+	defer func() {
+		err = errorsx.Compact(err, errorsx.Ignore(tx.Rollback(), sql.ErrTxDone))
+	}()
 
-	q := fmt.Sprintf("CREATE TABLE %s AS %s", table, query)
+	// temporary tables are private to the connection, the table is explicitly
+	// dropped as well as rolled back to ensure it never outlives the lookup.
+	q := fmt.Sprintf("CREATE TEMP TABLE %s AS SELECT * FROM (%s) LIMIT 0", table, query)
 	if _, err = tx.Exec(q); err != nil {
 		return nil, errorsx.Wrapf(err, "failure to execute %s", q)
 	}
+	defer func() {
+		_, derr := tx.Exec(fmt.Sprintf("DROP TABLE IF EXISTS temp.%s", table))
+		err = errorsx.Compact(err, errorsx.Wrap(derr, "failure to drop query columns table"))
+	}()
 
 	return columnInformation(d, tx, columnInformationQuery, table)
 }
 
+// ParameterInformationForQuery sqlite parameters are untyped, no information is available.
+func (t dialectImplementation) ParameterInformationForQuery(d genieql.Driver, query string) ([]genieql.ColumnInfo, error) {
+	return []genieql.ColumnInfo(nil), nil
+}
+
+func (t dialectImplementation) Validate(query string) error {
+	return sqlx.Validate(t.db, query)
+}
+
 func (t dialectImplementation) QuotedString(s string) string {
 	return s
 }
 
+func (t dialectImplementation) SQLDB(cb func(db *sql.DB)) {
+	cb(t.db)
+}
+
 func columnInformation(d genieql.Driver, q queryer, query, table string) ([]genieql.ColumnInfo, error) {
+	type column struct {
+		name     string
+		declared string // declared type of the column.
+		notnull  int
+		pk       int // 1-based position within the primary key, 0 when not part of the key.
+		hidden   int // 1 hidden column of a virtual table, 2 and 3 generated columns.
+	}
+
 	var (
 		err     error
 		rows    *sql.Rows
+		found   []column
 		columns []genieql.ColumnInfo
 	)
 
-	if rows, err = q.Query(fmt.Sprintf(query, table)); err != nil {
+	if rows, err = q.Query(query, table); err != nil {
 		return nil, errorsx.Wrapf(err, "failed to query column information: %s, %s", query, table)
 	}
 
 	for rows.Next() {
+		var c column
+
+		if err = rows.Scan(&c.name, &c.declared, &c.notnull, &c.pk, &c.hidden); err != nil {
+			rows.Close()
+			return nil, errorsx.Wrapf(err, "error scanning column information for table (%s): %s", table, query)
+		}
+
+		found = append(found, c)
+	}
+
+	if err = errorsx.Compact(rows.Err(), rows.Close()); err != nil {
+		return nil, errorsx.Wrap(err, "error retrieving column information")
+	}
+
+	// the primary key columns are ordered by their position within the key,
+	// followed by the remaining columns ordered by name.
+	slices.SortStableFunc(found, func(a, b column) int {
+		switch {
+		case a.pk > 0 && b.pk > 0:
+			return a.pk - b.pk
+		case a.pk > 0:
+			return -1
+		case b.pk > 0:
+			return 1
+		default:
+			return strings.Compare(a.name, b.name)
+		}
+	})
+
+	for _, c := range found {
 		var (
-			columndef  genieql.ColumnDefinition
-			id         int         // ignored.
-			name       string      // column name.
-			expr       string      // column type.
-			nullable   int         // nullable.
-			defaultVal interface{} // ignored.
-			primary    int         // part of the primary key.
+			columndef genieql.ColumnDefinition
 		)
 
-		if err = rows.Scan(&id, &name, &expr, &nullable, &defaultVal, &primary); err != nil {
-			return nil, errorsx.Wrapf(err, "error scanning column information for table (%s): %s", table, query)
+		if c.hidden == hiddenVirtual {
+			debugx.Println("skipping hidden column", c.name)
+			continue
 		}
 
-		if columndef, err = d.LookupType(expr); err != nil {
-			log.Println("skipping column", name, "driver missing type", expr, "please open an issue")
+		if columndef, err = lookupType(d, c.declared); err != nil {
+			log.Println("skipping column", c.name, "driver missing type", c.declared, "please open an issue")
 			continue
 		}
 
-		columndef.Nullable = isNullable(nullable)
-		columndef.PrimaryKey = isPrimary(primary)
+		columndef.PrimaryKey = isPrimary(c.pk)
+		// generated columns can not be written, they're only available to queries.
+		columndef.Generated = c.hidden == hiddenGenerated || c.hidden == hiddenStored
+
+		switch columndef.Native {
+		case "[]byte":
+			columndef.Nullable = false
+		default:
+			columndef.Nullable = isNullable(c.notnull, c.pk)
+		}
 
-		debugx.Println("found column", name, expr, spew.Sdump(columndef))
+		debugx.Println("found column", c.name, c.declared, spew.Sdump(columndef))
 
 		columns = append(columns, genieql.ColumnInfo{
-			Name:       name,
+			Name:       c.name,
 			Definition: columndef,
 		})
 	}
 
-	columns = genieql.SortColumnInfo(columns)(genieql.ByName)
+	return columns, nil
+}
+
+// lookupType resolves the declared type of a column. the declared type is looked up as is,
+// then by its name, i.e.) VARCHAR(255) as VARCHAR, and finally by the affinity sqlite
+// assigns the column. see https://www.sqlite.org/datatype3.html#determination_of_column_affinity
+func lookupType(d genieql.Driver, declared string) (columndef genieql.ColumnDefinition, err error) {
+	if columndef, err = d.LookupType(declared); err == nil {
+		return columndef, nil
+	}
+
+	name, _, _ := strings.Cut(strings.ToUpper(strings.TrimSpace(declared)), "(")
+	name = strings.TrimSpace(name)
+	if columndef, err = d.LookupType(name); err == nil {
+		return columndef, nil
+	}
 
-	return columns, errorsx.Wrap(rows.Err(), "error retrieving column information")
+	return d.LookupType(affinity(name))
 }
 
-func isNullable(i int) bool {
-	return i == 0
+// affinity of the declared type of a column.
+func affinity(declared string) string {
+	switch {
+	case strings.Contains(declared, "INT"):
+		return "INTEGER"
+	case strings.Contains(declared, "CHAR"), strings.Contains(declared, "CLOB"), strings.Contains(declared, "TEXT"):
+		return "TEXT"
+	case strings.Contains(declared, "BLOB"), declared == "":
+		return "BLOB"
+	case strings.Contains(declared, "REAL"), strings.Contains(declared, "FLOA"), strings.Contains(declared, "DOUB"):
+		return "REAL"
+	default:
+		return "NUMERIC"
+	}
+}
+
+// values of the hidden column of pragma_table_xinfo.
+const (
+	hiddenVirtual   = 1 // hidden column of a virtual table.
+	hiddenGenerated = 2 // generated virtual column.
+	hiddenStored    = 3 // generated stored column.
+)
+
+// isNullable sqlite permits NULL primary keys for legacy reasons, they're treated as
+// required and rowid aliases (INTEGER PRIMARY KEY) can never be NULL.
+func isNullable(notnull int, pk int) bool {
+	return notnull == 0 && pk == 0
 }
 
-func isPrimary(i int) bool {
-	return i == 0
+// isPrimary pk is the 1-based position of the column within the primary key.
+func isPrimary(pk int) bool {
+	return pk > 0
 }
@@ -3,9 +3,12 @@ package sqlite3_test
 import (
 	"database/sql"
 	"os"
+	"path/filepath"
 
 	"github.com/james-lawrence/genieql"
+	"github.com/james-lawrence/genieql/dialects"
 	"github.com/james-lawrence/genieql/internal/drivers"
+	"github.com/james-lawrence/genieql/internal/snapshot"
 	. "github.com/james-lawrence/genieql/internal/sqlite3"
 	"github.com/james-lawrence/genieql/internal/testx"
 
@@ -18,7 +21,6 @@ var _ = Describe("Sqlite3", func() {
 		dbfile  *os.File
 		db      *sql.DB
 		dialect genieql.Dialect
-		driver  = testx.Must(genieql.LookupDriver(drivers.StandardLib))
 	)
 
 	BeforeEach(func() {
@@ -47,9 +49,9 @@ var _ = Describe("Sqlite3", func() {
 		func(table, conflict string, columns, defaults []string, query string) {
 			Expect(dialect.Insert(1, 0, table, conflict, columns, columns, defaults)).To(Equal(query))
 		},
-		Entry("example 1", "MyTable1", "", []string{"col1", "col2", "col3"}, []string{}, "INSERT INTO MyTable1 (col1,col2,col3) VALUES ($1,$2,$3)"),
-		Entry("example 2", "MyTable2", "", []string{"col1", "col2", "col3", "col4"}, []string{"col4"}, "INSERT INTO MyTable2 (col1,col2,col3,col4) VALUES ($1,$2,$3,DEFAULT)"),
-		Entry("example 3", "MyTable2", "", []string{"col1", "col2", "col3", "col4"}, []string{"col1", "col3"}, "INSERT INTO MyTable2 (col1,col2,col3,col4) VALUES (DEFAULT,$1,DEFAULT,$2)"),
+		Entry("example 1", "MyTable1", "", []string{"col1", "col2", "col3"}, []string{}, "INSERT INTO MyTable1 (col1,col2,col3) VALUES ($1,$2,$3) RETURNING col1,col2,col3"),
+		Entry("example 2", "MyTable2", "", []string{"col1", "col2", "col3", "col4"}, []string{"col4"}, "INSERT INTO MyTable2 (col1,col2,col3) VALUES ($1,$2,$3) RETURNING col1,col2,col3,col4"),
+		Entry("example 3", "MyTable2", "", []string{"col1", "col2", "col3", "col4"}, []string{"col1", "col3"}, "INSERT INTO MyTable2 (col2,col4) VALUES ($1,$2) RETURNING col1,col2,col3,col4"),
 	)
 
 	DescribeTable("Select",
@@ -64,39 +66,224 @@ var _ = Describe("Sqlite3", func() {
 		func(table string, columns, predicates []string, query string) {
 			Expect(dialect.Update(table, columns, predicates, columns)).To(Equal(query))
 		},
-		Entry("example 1", "MyTable1", []string{"col1", "col2", "col3"}, []string{"col1"}, "UPDATE MyTable1 SET col1 = $1, col2 = $2, col3 = $3 WHERE col1 = $4"),
-		Entry("example 2", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{"col1", "col2"}, "UPDATE MyTable2 SET col1 = $1, col2 = $2, col3 = $3, col4 = $4 WHERE col1 = $5 AND col2 = $6"),
-		Entry("example 3", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{}, "UPDATE MyTable2 SET col1 = $1, col2 = $2, col3 = $3, col4 = $4 WHERE 't'"),
+		Entry("example 1", "MyTable1", []string{"col1", "col2", "col3"}, []string{"col1"}, "UPDATE MyTable1 SET col1 = $1, col2 = $2, col3 = $3 WHERE col1 = $4 RETURNING col1,col2,col3"),
+		Entry("example 2", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{"col1", "col2"}, "UPDATE MyTable2 SET col1 = $1, col2 = $2, col3 = $3, col4 = $4 WHERE col1 = $5 AND col2 = $6 RETURNING col1,col2,col3,col4"),
+		Entry("example 3", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{}, "UPDATE MyTable2 SET col1 = $1, col2 = $2, col3 = $3, col4 = $4 WHERE 't' RETURNING col1,col2,col3,col4"),
 	)
 
 	DescribeTable("Delete",
 		func(table string, columns, predicates []string, query string) {
 			Expect(dialect.Delete(table, columns, predicates)).To(Equal(query))
 		},
-		Entry("example 1", "MyTable1", []string{"col1", "col2", "col3"}, []string{"col1"}, "DELETE FROM MyTable1 WHERE col1 = $1"),
-		Entry("example 2", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{"col1", "col2"}, "DELETE FROM MyTable2 WHERE col1 = $1 AND col2 = $2"),
-		Entry("example 3", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{}, "DELETE FROM MyTable2 WHERE 't'"),
+		Entry("example 1", "MyTable1", []string{"col1", "col2", "col3"}, []string{"col1"}, "DELETE FROM MyTable1 WHERE col1 = $1 RETURNING col1,col2,col3"),
+		Entry("example 2", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{"col1", "col2"}, "DELETE FROM MyTable2 WHERE col1 = $1 AND col2 = $2 RETURNING col1,col2,col3,col4"),
+		Entry("example 3", "MyTable2", []string{"col1", "col2", "col3", "col4"}, []string{}, "DELETE FROM MyTable2 WHERE 't' RETURNING col1,col2,col3,col4"),
 	)
+})
 
-	Describe("ColumnInformationForTable", func() {
-		PIt("should return an array of genieql.ColumnInfo", func() {
-			columnInfo, err := dialect.ColumnInformationForTable(driver, "example")
-			Expect(err).ToNot(HaveOccurred())
-			Expect(columnInfo).To(ConsistOf(
-				genieql.ColumnInfo{Name: "id", Definition: genieql.ColumnDefinition{Nullable: false, PrimaryKey: true, Type: "integer"}},
-				genieql.ColumnInfo{Name: "name", Definition: genieql.ColumnDefinition{Nullable: true, PrimaryKey: false, Type: "text"}},
-			))
-		})
+var _ = Describe("column introspection", func() {
+	var (
+		db      *sql.DB
+		dialect genieql.Dialect
+		driver  = testx.Must(genieql.LookupDriver(drivers.SQLite))
+	)
+
+	column := func(name string, typ string, nullable, primary bool) genieql.ColumnInfo {
+		def := testx.Must(driver.LookupType(typ))
+		def.Nullable = nullable
+		def.PrimaryKey = primary
+		return genieql.ColumnInfo{Name: name, Definition: def}
+	}
+
+	generated := func(c genieql.ColumnInfo) genieql.ColumnInfo {
+		c.Definition.Generated = true
+		return c
+	}
+
+	BeforeEach(func() {
+		var (
+			err error
+		)
+
+		db, err = sql.Open("sqlite3", ":memory:")
+		Expect(err).ToNot(HaveOccurred())
+		// every connection has its own in memory database.
+		db.SetMaxOpenConns(1)
+
+		dialect = NewDialect(db)
 	})
 
-	Describe("ColumnInformationForQuery", func() {
-		PIt("should return an array of genieql.ColumnInfo", func() {
-			columnInfo, err := dialect.ColumnInformationForQuery(driver, "SELECT id,name FROM example")
+	AfterEach(func() {
+		Expect(db.Close()).ToNot(HaveOccurred())
+	})
+
+	DescribeTable("ColumnInformationForTable",
+		func(schema string, table string, expected ...genieql.ColumnInfo) {
+			_, err := db.Exec(schema)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dialect.ColumnInformationForTable(driver, table)).To(Equal(expected))
+		},
+		Entry(
+			"nullability",
+			"CREATE TABLE example (id integer primary key, name text, email text not null)",
+			"example",
+			column("id", "INTEGER", false, true),
+			column("email", "TEXT", false, false),
+			column("name", "TEXT", true, false),
+		),
+		Entry(
+			"composite primary keys are ordered by their position within the key",
+			"CREATE TABLE example (a text, b integer, c text, d real, PRIMARY KEY (c, a))",
+			"example",
+			column("c", "TEXT", false, true),
+			column("a", "TEXT", false, true),
+			column("b", "INTEGER", true, false),
+			column("d", "REAL", true, false),
+		),
+		Entry(
+			"generated columns are read only",
+			"CREATE TABLE example (id integer primary key, a integer not null, b integer GENERATED ALWAYS AS (a * 2) VIRTUAL, c integer AS (a + 1) STORED)",
+			"example",
+			column("id", "INTEGER", false, true),
+			column("a", "INTEGER", false, false),
+			generated(column("b", "INTEGER", true, false)),
+			generated(column("c", "INTEGER", true, false)),
+		),
+		Entry(
+			"declared types are mapped by their affinity",
+			"CREATE TABLE example (a varchar(255) not null, b bigint not null, c double precision not null, d decimal(10,5) not null, e not null, f clob not null, g datetime not null)",
+			"example",
+			column("a", "TEXT", false, false),
+			column("b", "INTEGER", false, false),
+			column("c", "REAL", false, false),
+			column("d", "NUMERIC", false, false),
+			column("e", "BLOB", false, false),
+			column("f", "TEXT", false, false),
+			column("g", "DATETIME", false, false),
+		),
+		Entry(
+			"binary columns are never nullable",
+			"CREATE TABLE example (id integer primary key, data blob)",
+			"example",
+			column("id", "INTEGER", false, true),
+			column("data", "BLOB", false, false),
+		),
+	)
+
+	DescribeTable("ColumnInformationForQuery",
+		func(query string, expected ...genieql.ColumnInfo) {
+			_, err := db.Exec("CREATE TABLE example (id integer primary key, name text not null, score real)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dialect.ColumnInformationForQuery(driver, query)).To(Equal(expected))
+
+			var count int
+			Expect(db.QueryRow("SELECT COUNT(*) FROM sqlite_temp_master WHERE name = 'genieql_query_columns_table'").Scan(&count)).To(Succeed())
+			Expect(count).To(Equal(0))
+		},
+		Entry(
+			"example 1",
+			"SELECT id, name, score FROM example",
+			column("id", "INTEGER", true, false),
+			column("name", "TEXT", true, false),
+			column("score", "REAL", true, false),
+		),
+		Entry(
+			"expressions without an affinity are blobs",
+			"SELECT id, COUNT(*) AS total, name || 'suffix' AS label FROM example GROUP BY id",
+			column("id", "INTEGER", true, false),
+			column("label", "BLOB", false, false),
+			column("total", "BLOB", false, false),
+		),
+	)
+})
+
+var _ = Describe("offline introspection", func() {
+	var (
+		driver = testx.Must(genieql.LookupDriver(drivers.SQLite))
+	)
+
+	DescribeTable("matches the database",
+		func(schema string, table string) {
+			dir := GinkgoT().TempDir()
+			Expect(os.WriteFile(filepath.Join(dir, "1_schema.sql"), []byte(schema), 0600)).To(Succeed())
+
+			db, err := sql.Open("sqlite3", ":memory:")
+			Expect(err).ToNot(HaveOccurred())
+			defer db.Close()
+			db.SetMaxOpenConns(1)
+
+			_, err = db.Exec(schema)
 			Expect(err).ToNot(HaveOccurred())
-			Expect(columnInfo).To(ConsistOf(
-				genieql.ColumnInfo{Name: "id", Definition: genieql.ColumnDefinition{Nullable: true, PrimaryKey: false, Type: "INT"}},
-				genieql.ColumnInfo{Name: "name", Definition: genieql.ColumnDefinition{Nullable: true, PrimaryKey: false, Type: "TEXT"}},
-			))
-		})
+
+			offline, err := dialects.LookupDialect(genieql.Configuration{Dialect: Dialect, Migrations: dir})
+			Expect(err).ToNot(HaveOccurred())
+
+			expected, err := NewDialect(db).ColumnInformationForTable(driver, table)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(offline.ColumnInformationForTable(driver, table)).To(Equal(expected))
+		},
+		Entry(
+			"nullability",
+			"CREATE TABLE example (id integer primary key, name text, email text not null)",
+			"example",
+		),
+		Entry(
+			"composite primary keys",
+			"CREATE TABLE example (a text, b integer, c text, d real, PRIMARY KEY (c, a))",
+			"example",
+		),
+		Entry(
+			"generated columns",
+			"CREATE TABLE example (id integer primary key, a integer not null, b integer GENERATED ALWAYS AS (a * 2) VIRTUAL, c integer AS (a + 1) STORED)",
+			"example",
+		),
+		Entry(
+			"declared types",
+			"CREATE TABLE example (a varchar(255) not null, b bigint not null, c double precision not null, d decimal(10,5) not null, e not null, f clob not null, g datetime not null, h blob)",
+			"example",
+		),
+		Entry(
+			"altered tables",
+			"CREATE TABLE example (id integer primary key, a text); ALTER TABLE example ADD COLUMN b integer not null default 0; ALTER TABLE example RENAME COLUMN a TO c; ALTER TABLE example DROP COLUMN b",
+			"example",
+		),
+	)
+
+	It("should require a database to describe queries", func() {
+		offline, err := dialects.LookupDialect(genieql.Configuration{Dialect: Dialect, Migrations: GinkgoT().TempDir()})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = offline.ColumnInformationForQuery(driver, "SELECT 1")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("schema snapshot", func() {
+	var (
+		driver = testx.Must(genieql.LookupDriver(drivers.SQLite))
+	)
+
+	It("should replay the snapshot instead of connecting to the database", func() {
+		dir := GinkgoT().TempDir()
+		config := genieql.Configuration{Dialect: Dialect, Location: dir, Name: "default.config", Database: filepath.Join(dir, "missing", "example.db")}
+
+		db, err := sql.Open("sqlite3", ":memory:")
+		Expect(err).ToNot(HaveOccurred())
+		defer db.Close()
+		db.SetMaxOpenConns(1)
+
+		_, err = db.Exec("CREATE TABLE example (id integer primary key, name text, email text not null)")
+		Expect(err).ToNot(HaveOccurred())
+
+		recorder := snapshot.NewRecorder()
+		expected, err := recorder.Dialect(NewDialect(db)).ColumnInformationForTable(driver, "example")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(snapshot.Write(config.SnapshotPath(), recorder.Snapshot())).To(Succeed())
+
+		replay, err := dialects.LookupDialect(config)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(replay.ColumnInformationForTable(driver, "example")).To(Equal(expected))
+		_, err = replay.ColumnInformationForTable(driver, "unknown")
+		Expect(err).To(HaveOccurred())
+		Expect(replay.Insert(1, 0, "example", "", []string{"id"}, []string{"id"}, nil)).To(Equal(NewDialect(db).Insert(1, 0, "example", "", []string{"id"}, []string{"id"}, nil)))
 	})
 })
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"log"
+
+	"github.com/james-lawrence/genieql/internal/errorsx"
 )
 
 // Queryer interface for executing queries.
@@ -59,3 +61,25 @@ func (t DebuggingQueryer) ExecContext(ctx context.Context, q string, args ...int
 	log.Printf("%s:\n%#v\n", q, args)
 	return t.Delegate.ExecContext(ctx, q, args...)
 }
+
+// Validate prepares the query within a transaction that is rolled back, returning
+// the error reported by the database for invalid queries.
+func Validate(db *sql.DB, query string) (err error) {
+	var (
+		tx   *sql.Tx
+		stmt *sql.Stmt
+	)
+
+	if tx, err = db.Begin(); err != nil {
+		return errorsx.Wrap(err, "failure to start transaction")
+	}
+	defer func() {
+		err = errorsx.Compact(err, errorsx.Ignore(tx.Rollback(), sql.ErrTxDone))
+	}()
+
+	if stmt, err = tx.Prepare(query); err != nil {
+		return errorsx.Wrapf(err, "invalid query: %s", query)
+	}
+
+	return stmt.Close()
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/james-lawrence/genieql/dialects"
 	"github.com/james-lawrence/genieql/internal/bytesx"
 	"github.com/james-lawrence/genieql/internal/errorsx"
+	"github.com/james-lawrence/genieql/internal/mysql"
 	"github.com/james-lawrence/genieql/internal/postgresql"
 	"github.com/james-lawrence/genieql/internal/wasix/ffierrors"
 	"github.com/james-lawrence/genieql/internal/wasix/ffiguest"
@@ -26,7 +27,16 @@ func init() {
 type dialectFactory struct{}
 
 func (t dialectFactory) Connect(config genieql.Configuration) (_ genieql.Dialect, err error) {
-	return New(), nil
+	switch config.Dialect {
+	case mysql.Dialect:
+		// placeholders and identifiers are rendered by the guest, they must match the host dialect.
+		return dialect{
+			columntrans:         mysql.NewColumnValueTransformer,
+			columnnametransform: mysql.NewColumnNameTransformer,
+		}, nil
+	default:
+		return New(), nil
+	}
 }
 
 func New() dialect {
@@ -124,6 +134,64 @@ func (t dialect) Update(table string, columns, predicates, returning []string) s
 	return decoded
 }
 
+func (t dialect) UpdateBatch(n int, table string, columns, types, predicates, returning []string) string {
+	var (
+		rs = make([]byte, 0, 2*bytesx.MiB)
+	)
+	tableptr, tablelen := ffiguest.String(table)
+	columnsptr, columnslen, columnssize := ffiguest.StringArray(columns...)
+	typesptr, typeslen, typessize := ffiguest.StringArray(types...)
+	predicatesptr, predicateslen, predicatessize := ffiguest.StringArray(predicates...)
+	returningptr, returninglen, returningsize := ffiguest.StringArray(returning...)
+
+	_, rptr, rlen := ffiguest.ByteBuffer(rs)
+
+	errorsx.MaybePanic(ffierrors.Error(
+		_updatebatchquery(
+			int64(n),
+			tableptr, tablelen,
+			columnsptr, columnslen, columnssize,
+			typesptr, typeslen, typessize,
+			predicatesptr, predicateslen, predicatessize,
+			returningptr, returninglen, returningsize,
+			unsafe.Pointer(&rlen),
+			rptr,
+		),
+		errors.New("unable generate batch update"),
+	))
+	decoded := unsafe.String(unsafe.SliceData(rs), rlen)
+
+	return decoded
+}
+
+func (t dialect) DeleteBatch(n int, table string, columns, types, returning []string) string {
+	var (
+		rs = make([]byte, 0, 2*bytesx.MiB)
+	)
+	tableptr, tablelen := ffiguest.String(table)
+	columnsptr, columnslen, columnssize := ffiguest.StringArray(columns...)
+	typesptr, typeslen, typessize := ffiguest.StringArray(types...)
+	returningptr, returninglen, returningsize := ffiguest.StringArray(returning...)
+
+	_, rptr, rlen := ffiguest.ByteBuffer(rs)
+
+	errorsx.MaybePanic(ffierrors.Error(
+		_deletebatchquery(
+			int64(n),
+			tableptr, tablelen,
+			columnsptr, columnslen, columnssize,
+			typesptr, typeslen, typessize,
+			returningptr, returninglen, returningsize,
+			unsafe.Pointer(&rlen),
+			rptr,
+		),
+		errors.New("unable generate batch delete"),
+	))
+	decoded := unsafe.String(unsafe.SliceData(rs), rlen)
+
+	return decoded
+}
+
 func (t dialect) Delete(table string, columns, predicates []string) string {
 	var (
 		rs = make([]byte, 0, 2*bytesx.MiB)
@@ -149,6 +217,103 @@ func (t dialect) Delete(table string, columns, predicates []string) string {
 	return decoded
 }
 
+func (t dialect) Conflict(target, updates []string) string {
+	var (
+		rs = make([]byte, 0, 2*bytesx.MiB)
+	)
+	targetptr, targetlen, targetsize := ffiguest.StringArray(target...)
+	updatesptr, updateslen, updatessize := ffiguest.StringArray(updates...)
+
+	_, rptr, rlen := ffiguest.ByteBuffer(rs)
+
+	errorsx.MaybePanic(ffierrors.Error(
+		_conflictquery(
+			targetptr, targetlen, targetsize,
+			updatesptr, updateslen, updatessize,
+			unsafe.Pointer(&rlen),
+			rptr,
+		),
+		errors.New("unable generate conflict"),
+	))
+	decoded := unsafe.String(unsafe.SliceData(rs), rlen)
+
+	return decoded
+}
+
+func (t dialect) Paginate(table string, columns, keys []string, after bool) string {
+	var (
+		rs     = make([]byte, 0, 2*bytesx.MiB)
+		cursor uint32
+	)
+
+	if after {
+		cursor = 1
+	}
+
+	tableptr, tablelen := ffiguest.String(table)
+	columnsptr, columnslen, columnssize := ffiguest.StringArray(columns...)
+	keysptr, keyslen, keyssize := ffiguest.StringArray(keys...)
+
+	_, rptr, rlen := ffiguest.ByteBuffer(rs)
+
+	errorsx.MaybePanic(ffierrors.Error(
+		_paginatequery(
+			tableptr, tablelen,
+			columnsptr, columnslen, columnssize,
+			keysptr, keyslen, keyssize,
+			cursor,
+			unsafe.Pointer(&rlen),
+			rptr,
+		),
+		errors.New("unable generate paginate"),
+	))
+	decoded := unsafe.String(unsafe.SliceData(rs), rlen)
+
+	return decoded
+}
+
+func (t dialect) Membership(placeholder string) string {
+	var (
+		rs = make([]byte, 0, 1024)
+	)
+	sptr, slen := ffiguest.String(placeholder)
+	_, rptr, rlen := ffiguest.ByteBuffer(rs)
+
+	errorsx.MaybePanic(ffierrors.Error(
+		_membership(sptr, slen, unsafe.Pointer(&rlen), rptr),
+		errors.New("unable to generate membership"),
+	))
+	decoded := unsafe.String(unsafe.SliceData(rs), rlen)
+
+	return decoded
+}
+
+func (t dialect) MaxParameters() int {
+	var (
+		n uint32
+	)
+
+	errorsx.MaybePanic(ffierrors.Error(
+		_maxparameters(unsafe.Pointer(&n)),
+		errors.New("unable to determine maximum parameters"),
+	))
+
+	return int(n)
+}
+
+func (t dialect) Returning() bool {
+	var (
+		b byte
+	)
+
+	errorsx.MaybePanic(ffierrors.Error(
+		_returning(unsafe.Pointer(&b)),
+		errors.New("unable to determine returning support"),
+	))
+
+	return ffiguest.Bool(b)
+}
+
 func (t dialect) ColumnValueTransformer() genieql.ColumnTransformer {
 	return t.columntrans()
 }
@@ -203,6 +368,54 @@ func (t dialect) ColumnInformationForQuery(d genieql.Driver, query string) (res
 	return res, nil
 }
 
+func (t dialect) ParameterInformationForQuery(d genieql.Driver, query string) (res []genieql.ColumnInfo, err error) {
+	var (
+		rs = make([]byte, 0, 2*bytesx.MiB)
+	)
+
+	sptr, slen := ffiguest.String(query)
+	_, rptr, rlen := ffiguest.ByteBuffer(rs)
+
+	err = ffierrors.Error(
+		_parameterinformationForQuery(sptr, slen, unsafe.Pointer(&rlen), rptr),
+		fmt.Errorf("unable to query parameter information for query: %s", query),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(ffiguest.ByteBufferRead(rptr, rlen), &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Validate the query against the database of the host. the host writes the
+// error reported by the database, an empty result indicates a valid query.
+func (t dialect) Validate(query string) (err error) {
+	var (
+		rs = make([]byte, 0, 64*bytesx.KiB)
+	)
+
+	qptr, qlen := ffiguest.String(query)
+	_, rptr, rlen := ffiguest.ByteBuffer(rs)
+
+	err = ffierrors.Error(
+		_validate(qptr, qlen, unsafe.Pointer(&rlen), rptr),
+		fmt.Errorf("unable to validate query: %s", query),
+	)
+	if err != nil {
+		return err
+	}
+
+	if rlen == 0 {
+		return nil
+	}
+
+	return errors.New(string(ffiguest.ByteBufferRead(rptr, rlen)))
+}
+
 func (t dialect) QuotedString(s string) string {
 	var (
 		rs = make([]byte, 0, 1024)
@@ -57,6 +57,75 @@ func _deletequery(
 	return ffierrors.ErrNotImplemented
 }
 
+// UpdateBatch(n int, table string, columns, types, predicates, returning []string) string
+func _updatebatchquery(
+	n int64,
+	tableptr unsafe.Pointer, tablelen uint32,
+	columnsptr unsafe.Pointer, columnslen uint32, columnssize uint32,
+	typesptr unsafe.Pointer, typeslen uint32, typessize uint32,
+	predicatesptr unsafe.Pointer, predicateslen uint32, predicatessize uint32,
+	returningptr unsafe.Pointer, returninglen uint32, returningsize uint32,
+	rlen unsafe.Pointer,
+	rptr unsafe.Pointer,
+) (errcode uint32) {
+	return ffierrors.ErrNotImplemented
+}
+
+// DeleteBatch(n int, table string, columns, types, returning []string) string
+func _deletebatchquery(
+	n int64,
+	tableptr unsafe.Pointer, tablelen uint32,
+	columnsptr unsafe.Pointer, columnslen uint32, columnssize uint32,
+	typesptr unsafe.Pointer, typeslen uint32, typessize uint32,
+	returningptr unsafe.Pointer, returninglen uint32, returningsize uint32,
+	rlen unsafe.Pointer,
+	rptr unsafe.Pointer,
+) (errcode uint32) {
+	return ffierrors.ErrNotImplemented
+}
+
+// Conflict(target, updates []string) string
+func _conflictquery(
+	targetptr unsafe.Pointer, targetlen uint32, targetsize uint32,
+	updatesptr unsafe.Pointer, updateslen uint32, updatessize uint32,
+	rlen unsafe.Pointer,
+	rptr unsafe.Pointer,
+) (errcode uint32) {
+	return ffierrors.ErrNotImplemented
+}
+
+// Paginate(table string, columns, keys []string, after bool) string
+func _paginatequery(
+	tableptr unsafe.Pointer, tablelen uint32,
+	columnsptr unsafe.Pointer, columnslen uint32, columnssize uint32,
+	keysptr unsafe.Pointer, keyslen uint32, keyssize uint32,
+	after uint32,
+	rlen unsafe.Pointer,
+	rptr unsafe.Pointer,
+) (errcode uint32) {
+	return ffierrors.ErrNotImplemented
+}
+
+// Membership(placeholder string) string
+func _membership(sptr unsafe.Pointer, slen uint32, rlen unsafe.Pointer, rptr unsafe.Pointer) (errcode uint32) {
+	return ffierrors.ErrNotImplemented
+}
+
+// MaxParameters() int
+func _maxparameters(rptr unsafe.Pointer) (errcode uint32) {
+	return ffierrors.ErrNotImplemented
+}
+
+// Returning() bool
+func _returning(rptr unsafe.Pointer) (errcode uint32) {
+	return ffierrors.ErrNotImplemented
+}
+
+// Validate(query string) error
+func _validate(qptr unsafe.Pointer, qlen uint32, rlen unsafe.Pointer, rptr unsafe.Pointer) (errcode uint32) {
+	return ffierrors.ErrNotImplemented
+}
+
 // QuotedString(s string) string
 func _quotedString(sptr unsafe.Pointer, slen uint32, rlen unsafe.Pointer, rptr unsafe.Pointer) (errcode uint32) {
 	return ffierrors.ErrNotImplemented
@@ -71,3 +140,8 @@ func _columninformationForTable(sptr unsafe.Pointer, slen uint32, rlen unsafe.Po
 func _columninformationForQuery(sptr unsafe.Pointer, slen uint32, rlen unsafe.Pointer, rptr unsafe.Pointer) (errcode uint32) {
 	return ffierrors.ErrNotImplemented
 }
+
+// ParameterInformationForQuery(query string) ([]genieql.ColumnInfo, error)
+func _parameterinformationForQuery(sptr unsafe.Pointer, slen uint32, rlen unsafe.Pointer, rptr unsafe.Pointer) (errcode uint32) {
+	return ffierrors.ErrNotImplemented
+}
@@ -47,6 +47,59 @@ func _deletequery(
 	rptr unsafe.Pointer,
 ) (errcode uint32)
 
+//go:wasmimport env genieql/dialect.UpdateBatch
+func _updatebatchquery(
+	n int64,
+	tableptr unsafe.Pointer, tablelen uint32,
+	columnsptr unsafe.Pointer, columnslen uint32, columnssize uint32,
+	typesptr unsafe.Pointer, typeslen uint32, typessize uint32,
+	predicatesptr unsafe.Pointer, predicateslen uint32, predicatessize uint32,
+	returningptr unsafe.Pointer, returninglen uint32, returningsize uint32,
+	rlen unsafe.Pointer,
+	rptr unsafe.Pointer,
+) (errcode uint32)
+
+//go:wasmimport env genieql/dialect.DeleteBatch
+func _deletebatchquery(
+	n int64,
+	tableptr unsafe.Pointer, tablelen uint32,
+	columnsptr unsafe.Pointer, columnslen uint32, columnssize uint32,
+	typesptr unsafe.Pointer, typeslen uint32, typessize uint32,
+	returningptr unsafe.Pointer, returninglen uint32, returningsize uint32,
+	rlen unsafe.Pointer,
+	rptr unsafe.Pointer,
+) (errcode uint32)
+
+//go:wasmimport env genieql/dialect.Conflict
+func _conflictquery(
+	targetptr unsafe.Pointer, targetlen uint32, targetsize uint32,
+	updatesptr unsafe.Pointer, updateslen uint32, updatessize uint32,
+	rlen unsafe.Pointer,
+	rptr unsafe.Pointer,
+) (errcode uint32)
+
+//go:wasmimport env genieql/dialect.Paginate
+func _paginatequery(
+	tableptr unsafe.Pointer, tablelen uint32,
+	columnsptr unsafe.Pointer, columnslen uint32, columnssize uint32,
+	keysptr unsafe.Pointer, keyslen uint32, keyssize uint32,
+	after uint32,
+	rlen unsafe.Pointer,
+	rptr unsafe.Pointer,
+) (errcode uint32)
+
+//go:wasmimport env genieql/dialect.Membership
+func _membership(ptr unsafe.Pointer, len uint32, rlen unsafe.Pointer, rptr unsafe.Pointer) (errcode uint32)
+
+//go:wasmimport env genieql/dialect.MaxParameters
+func _maxparameters(rptr unsafe.Pointer) (errcode uint32)
+
+//go:wasmimport env genieql/dialect.Returning
+func _returning(rptr unsafe.Pointer) (errcode uint32)
+
+//go:wasmimport env genieql/dialect.Validate
+func _validate(qptr unsafe.Pointer, qlen uint32, rlen unsafe.Pointer, rptr unsafe.Pointer) (errcode uint32)
+
 //go:wasmimport env genieql/dialect.QuotedString
 func _quotedString(ptr unsafe.Pointer, len uint32, rlen unsafe.Pointer, rptr unsafe.Pointer) (errcode uint32)
 
@@ -55,3 +108,6 @@ func _columninformationForTable(sptr unsafe.Pointer, slen uint32, rlen unsafe.Po
 
 //go:wasmimport env genieql/dialect.ColumnInformationForQuery
 func _columninformationForQuery(sptr unsafe.Pointer, slen uint32, rlen unsafe.Pointer, rptr unsafe.Pointer) (errcode uint32)
+
+//go:wasmimport env genieql/dialect.ParameterInformationForQuery
+func _parameterinformationForQuery(sptr unsafe.Pointer, slen uint32, rlen unsafe.Pointer, rptr unsafe.Pointer) (errcode uint32)
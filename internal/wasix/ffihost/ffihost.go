@@ -156,3 +156,19 @@ func WriteJSON(m api.Memory, dmax uint32, dptr, dlenptr uint32, d any) (err erro
 
 	return nil
 }
+
+func WriteBytes(m api.Memory, dmax uint32, dptr, dlenptr uint32, d []byte) (err error) {
+	if len(d) > int(dmax) {
+		return fmt.Errorf("unable to write bytes buffer exceeded %d > %d", len(d), dmax)
+	}
+
+	if !m.Write(dptr, d) {
+		return fmt.Errorf("unable to write bytes: %d", dptr)
+	}
+
+	if !m.WriteUint32Le(dlenptr, uint32(len(d))) {
+		return fmt.Errorf("unable to write bytes length: %d", dlenptr)
+	}
+
+	return nil
+}
@@ -83,6 +83,22 @@ func NotPrimaryKeyFilter(column ColumnInfo) bool {
 	return columnInfoNotFilter(PrimaryKeyFilter)(column)
 }
 
+// Generated - returns the columns computed by the database from the column set.
+func (t ColumnInfoSet) Generated() ColumnInfoSet {
+	return t.Filter(GeneratedFilter)
+}
+
+// GeneratedFilter - selects ColumnInfo computed by the database, generated columns
+// are returned by queries but can not be written.
+func GeneratedFilter(column ColumnInfo) bool {
+	return column.Definition.Generated
+}
+
+// NotGeneratedFilter - inverse of GeneratedFilter
+func NotGeneratedFilter(column ColumnInfo) bool {
+	return columnInfoNotFilter(GeneratedFilter)(column)
+}
+
 func columnInfoNotFilter(x func(ColumnInfo) bool) func(ColumnInfo) bool {
 	return func(c ColumnInfo) bool {
 		return !x(c)